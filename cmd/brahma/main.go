@@ -8,11 +8,17 @@ import (
 	"syscall"
 
 	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/crash"
 	grpcserver "github.com/vtapaskar/brahma/internal/grpc"
+	"github.com/vtapaskar/brahma/internal/health"
 	"github.com/vtapaskar/brahma/internal/metrics"
 	"github.com/vtapaskar/brahma/internal/registry"
+	"github.com/vtapaskar/brahma/internal/server"
+	"github.com/vtapaskar/brahma/internal/sink"
 	"github.com/vtapaskar/brahma/internal/splunk"
+	"github.com/vtapaskar/brahma/internal/spool"
 	"github.com/vtapaskar/brahma/internal/storage"
+	"github.com/vtapaskar/brahma/internal/symbols"
 	"go.uber.org/zap"
 )
 
@@ -37,13 +43,82 @@ func main() {
 		logger.Fatal("Failed to initialize S3 client", zap.Error(err))
 	}
 
-	splunkClient := splunk.NewClient(cfg.Splunk, logger)
+	var splunkClient *splunk.Client
+	var metricsSpool *spool.Spool
+	sinks := make(map[string]sink.Sink)
 
-	deviceRegistry := registry.NewRegistry(splunkClient, logger)
+	if cfg.Sinks.Uses(sink.Splunk) {
+		splunkClient = splunk.NewClient(cfg.Splunk, logger)
 
-	metricsCollector := metrics.NewCollector(cfg.Metrics, splunkClient, s3Client, logger)
+		if cfg.Spool.Dir != "" {
+			metricsSpool, err = spool.Open(cfg.Spool, logger)
+			if err != nil {
+				logger.Fatal("Failed to open metrics spool", zap.Error(err))
+			}
+			go metricsSpool.Run(splunkClient.SendEvents)
+		}
+
+		sinks[sink.Splunk] = sink.NewSplunkSink(splunkClient, metricsSpool, logger)
+	}
+
+	if cfg.Sinks.Uses(sink.File) {
+		fileSink, err := sink.NewFileSink(cfg.Sinks.File.Path)
+		if err != nil {
+			logger.Fatal("Failed to open file sink", zap.Error(err))
+		}
+		sinks[sink.File] = fileSink
+	}
+
+	eventRouter, err := sink.NewRouter(sinks, cfg.Sinks.EffectiveRoutes())
+	if err != nil {
+		logger.Fatal("Failed to configure event sinks", zap.Error(err))
+	}
+
+	registryStore, err := registry.NewStore(cfg.Registry)
+	if err != nil {
+		logger.Fatal("Failed to open registry store", zap.Error(err))
+	}
+
+	deviceRegistry, err := registry.NewRegistry(cfg.Registry, registryStore, eventRouter, logger)
+	if err != nil {
+		logger.Fatal("Failed to load device registry", zap.Error(err))
+	}
 
-	grpcSrv := grpcserver.NewServer(cfg.GRPC, metricsCollector, deviceRegistry, logger)
+	livenessMonitor := registry.NewLivenessMonitor(cfg.Registry, deviceRegistry, logger)
+	go livenessMonitor.Run()
+
+	logIndex, err := metrics.NewLogIndex(cfg.Metrics.LogIndexPath)
+	if err != nil {
+		logger.Fatal("Failed to open log index", zap.Error(err))
+	}
+
+	crashIndex, err := crash.NewIndex(cfg.Crash)
+	if err != nil {
+		logger.Fatal("Failed to open crash bucket index", zap.Error(err))
+	}
+
+	var symbolStore *symbols.Store
+	if cfg.Symbols.Dir != "" {
+		symbolStore, err = symbols.NewStore(cfg.Symbols)
+		if err != nil {
+			logger.Fatal("Failed to open symbol store", zap.Error(err))
+		}
+	}
+
+	deviceExporter, err := metrics.NewDeviceExporter(cfg.Metrics, deviceRegistry)
+	if err != nil {
+		logger.Fatal("Failed to create device metrics exporter", zap.Error(err))
+	}
+
+	metricsCollector := metrics.NewCollector(cfg.Metrics, eventRouter, s3Client, logIndex, crashIndex, symbolStore, deviceExporter, logger)
+
+	healthChecker := health.NewChecker(cfg.Health, splunkClient, s3Client, metricsCollector, logger)
+	go healthChecker.Run()
+
+	grpcSrv, err := grpcserver.NewServer(cfg.GRPC, metricsCollector, deviceRegistry, healthChecker, logger)
+	if err != nil {
+		logger.Fatal("Failed to create gRPC server", zap.Error(err))
+	}
 
 	go func() {
 		if err := grpcSrv.Start(); err != nil {
@@ -56,11 +131,38 @@ func main() {
 		zap.Int("port", cfg.GRPC.Port),
 	)
 
+	var httpSrv *server.Server
+	if cfg.Server.Port > 0 {
+		httpSrv = server.NewServer(cfg.Server, cfg.GRPC, metricsCollector, deviceRegistry, healthChecker, deviceExporter, logger)
+
+		go func() {
+			if err := httpSrv.Start(); err != nil {
+				logger.Fatal("HTTP server failed", zap.Error(err))
+			}
+		}()
+	}
+
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 	<-sigChan
 
 	logger.Info("Shutting down...")
+	if httpSrv != nil {
+		httpSrv.Stop()
+	}
 	grpcSrv.Stop()
+	healthChecker.Stop()
 	metricsCollector.Stop()
+	logIndex.Close()
+	crashIndex.Close()
+	livenessMonitor.Stop()
+	if err := deviceRegistry.Close(); err != nil {
+		logger.Error("Failed to close device registry", zap.Error(err))
+	}
+	if err := eventRouter.Close(); err != nil {
+		logger.Error("Failed to close event sinks", zap.Error(err))
+	}
+	if metricsSpool != nil {
+		metricsSpool.Close()
+	}
 }
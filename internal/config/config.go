@@ -7,11 +7,17 @@ import (
 )
 
 type Config struct {
-	Server  ServerConfig  `json:"server"`
-	GRPC    GRPCConfig    `json:"grpc"`
-	Splunk  SplunkConfig  `json:"splunk"`
-	S3      S3Config      `json:"s3"`
-	Metrics MetricsConfig `json:"metrics"`
+	Server   ServerConfig   `json:"server"`
+	GRPC     GRPCConfig     `json:"grpc"`
+	Splunk   SplunkConfig   `json:"splunk"`
+	S3       S3Config       `json:"s3"`
+	Metrics  MetricsConfig  `json:"metrics"`
+	Registry RegistryConfig `json:"registry"`
+	Spool    SpoolConfig    `json:"spool"`
+	Health   HealthConfig   `json:"health"`
+	Sinks    SinksConfig    `json:"sinks"`
+	Crash    CrashConfig    `json:"crash"`
+	Symbols  SymbolsConfig  `json:"symbols"`
 }
 
 type ServerConfig struct {
@@ -20,21 +26,25 @@ type ServerConfig struct {
 }
 
 type GRPCConfig struct {
-	Address   string `json:"address"`
-	Port      int    `json:"port"`
-	EnableTLS bool   `json:"enable_tls"`
-	CertFile  string `json:"cert_file"`
-	KeyFile   string `json:"key_file"`
+	Address      string `json:"address"`
+	Port         int    `json:"port"`
+	EnableTLS    bool   `json:"enable_tls"`
+	CertFile     string `json:"cert_file"`
+	KeyFile      string `json:"key_file"`
+	ClientCAFile string `json:"client_ca_file"`
+	AdminToken   string `json:"admin_token"`
 }
 
 type SplunkConfig struct {
-	Host       string `json:"host"`
-	Port       int    `json:"port"`
-	Token      string `json:"token"`
-	Index      string `json:"index"`
-	Source     string `json:"source"`
-	SourceType string `json:"source_type"`
-	UseTLS     bool   `json:"use_tls"`
+	Host           string `json:"host"`
+	Port           int    `json:"port"`
+	Token          string `json:"token"`
+	Index          string `json:"index"`
+	Source         string `json:"source"`
+	SourceType     string `json:"source_type"`
+	UseTLS         bool   `json:"use_tls"`
+	BatchMaxEvents int    `json:"batch_max_events"`
+	BatchMaxBytes  int    `json:"batch_max_bytes"`
 }
 
 type S3Config struct {
@@ -50,6 +60,80 @@ type MetricsConfig struct {
 	BufferSize    int      `json:"buffer_size"`
 	FlushInterval int      `json:"flush_interval_seconds"`
 	DeviceTypes   []string `json:"device_types"`
+	LogIndexPath  string   `json:"log_index_path"`
+	QueueSize     int      `json:"queue_size"`
+	Workers       int      `json:"workers"`
+	DeviceLabels  []string `json:"device_labels"`
+
+	TechSupportMaxBytes       int64 `json:"techsupport_max_bytes"`
+	TechSupportMaxMemberBytes int64 `json:"techsupport_max_member_bytes"`
+}
+
+type RegistryConfig struct {
+	Backend                 string `json:"backend"`
+	DataDir                 string `json:"data_dir"`
+	CompactionThreshold     int    `json:"compaction_threshold"`
+	StaleAfterSeconds       int    `json:"stale_after_seconds"`
+	OfflineAfterSeconds     int    `json:"offline_after_seconds"`
+	LivenessIntervalSeconds int    `json:"liveness_interval_seconds"`
+	WatchHistory            int    `json:"watch_history"`
+}
+
+type SpoolConfig struct {
+	Dir                   string `json:"dir"`
+	MaxBytes              int64  `json:"max_bytes"`
+	SegmentBytes          int64  `json:"segment_bytes"`
+	ReplayBatchEvents     int    `json:"replay_batch_events"`
+	InitialBackoffSeconds int    `json:"initial_backoff_seconds"`
+	MaxBackoffSeconds     int    `json:"max_backoff_seconds"`
+}
+
+type HealthConfig struct {
+	IntervalSeconds int     `json:"interval_seconds"`
+	TimeoutSeconds  int     `json:"timeout_seconds"`
+	QueueSaturation float64 `json:"queue_saturation"`
+}
+
+type CrashConfig struct {
+	SignatureFrames int    `json:"signature_frames"`
+	BucketIndexPath string `json:"bucket_index_path"`
+}
+
+type SymbolsConfig struct {
+	Dir string `json:"dir"`
+}
+
+// SinksConfig routes events to sinks by event type, e.g. "cpu_stats" or
+// "device_registered". Types without a route use the "*" route; a type routed
+// to an empty list is dropped. Without any routes everything goes to Splunk.
+type SinksConfig struct {
+	File   FileSinkConfig      `json:"file"`
+	Routes map[string][]string `json:"routes"`
+}
+
+type FileSinkConfig struct {
+	Path string `json:"path"`
+}
+
+var defaultRoutes = map[string][]string{"*": {"splunk"}}
+
+func (c SinksConfig) EffectiveRoutes() map[string][]string {
+	if len(c.Routes) == 0 {
+		return defaultRoutes
+	}
+	return c.Routes
+}
+
+// Uses reports whether any route sends events to the named sink.
+func (c SinksConfig) Uses(name string) bool {
+	for _, names := range c.EffectiveRoutes() {
+		for _, n := range names {
+			if n == name {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func Load(path string) (*Config, error) {
@@ -77,7 +161,31 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid grpc port: %d", c.GRPC.Port)
 	}
 
-	if c.Splunk.Host == "" {
+	if c.GRPC.EnableTLS && (c.GRPC.CertFile == "" || c.GRPC.KeyFile == "") {
+		return fmt.Errorf("grpc cert_file and key_file are required when enable_tls is set")
+	}
+
+	if c.GRPC.ClientCAFile != "" && !c.GRPC.EnableTLS {
+		return fmt.Errorf("grpc client_ca_file requires enable_tls")
+	}
+
+	if c.Server.Port < 0 || c.Server.Port > 65535 {
+		return fmt.Errorf("invalid server port: %d", c.Server.Port)
+	}
+
+	for eventType, names := range c.Sinks.Routes {
+		for _, name := range names {
+			if name != "splunk" && name != "file" {
+				return fmt.Errorf("sinks route %q uses unknown sink %q", eventType, name)
+			}
+		}
+	}
+
+	if c.Sinks.Uses("file") && c.Sinks.File.Path == "" {
+		return fmt.Errorf("sinks file path is required when routing to the file sink")
+	}
+
+	if c.Sinks.Uses("splunk") && c.Splunk.Host == "" {
 		return fmt.Errorf("splunk host is required")
 	}
 
@@ -89,5 +197,18 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("s3 region is required")
 	}
 
+	if c.Registry.Backend == "file" && c.Registry.DataDir == "" {
+		return fmt.Errorf("registry data_dir is required for the file backend")
+	}
+
+	if c.Registry.StaleAfterSeconds > 0 && c.Registry.OfflineAfterSeconds > 0 &&
+		c.Registry.OfflineAfterSeconds <= c.Registry.StaleAfterSeconds {
+		return fmt.Errorf("registry offline_after_seconds must be greater than stale_after_seconds")
+	}
+
+	if c.Health.QueueSaturation < 0 || c.Health.QueueSaturation > 1 {
+		return fmt.Errorf("health queue_saturation must be between 0 and 1")
+	}
+
 	return nil
 }
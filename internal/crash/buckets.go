@@ -0,0 +1,324 @@
+package crash
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/fsutil"
+)
+
+const (
+	defaultBucketListLimit = 100
+	maxBucketListLimit     = 1000
+)
+
+// Bucket aggregates every crash report that shares a signature.
+type Bucket struct {
+	Signature  string    `json:"signature"`
+	ProcessTag string    `json:"process_tag"`
+	Frames     []string  `json:"frames"`
+	FirstSeen  time.Time `json:"first_seen"`
+	LastSeen   time.Time `json:"last_seen"`
+	Count      int       `json:"count"`
+	Devices    []string  `json:"devices"`
+	Versions   []string  `json:"versions"`
+}
+
+func (b *Bucket) clone() *Bucket {
+	c := *b
+	c.Frames = append([]string(nil), b.Frames...)
+	c.Devices = append([]string(nil), b.Devices...)
+	c.Versions = append([]string(nil), b.Versions...)
+	return &c
+}
+
+// occurrence is one crash report assigned to a bucket, as journaled.
+type occurrence struct {
+	Signature  string    `json:"signature"`
+	ProcessTag string    `json:"process_tag"`
+	Frames     []string  `json:"frames"`
+	DeviceUID  string    `json:"device_uid"`
+	Version    string    `json:"version"`
+	Time       time.Time `json:"time"`
+}
+
+// journalLine is one line of the journal: either an occurrence, or a whole
+// bucket as written by compaction.
+type journalLine struct {
+	occurrence
+	Bucket *Bucket `json:"bucket,omitempty"`
+}
+
+type bucketLine struct {
+	Bucket *Bucket `json:"bucket"`
+}
+
+type BucketQuery struct {
+	ProcessTag string
+	Limit      int
+	Offset     int
+}
+
+// Index assigns crash reports to buckets. When a path is configured every
+// occurrence is appended to a JSON-lines journal that rebuilds the buckets on
+// startup, after which the journal is rewritten with one line per bucket.
+type Index struct {
+	depth   int
+	buckets map[string]*Bucket
+	file    *os.File
+	mu      sync.RWMutex
+}
+
+func NewIndex(cfg config.CrashConfig) (*Index, error) {
+	idx := &Index{
+		depth:   cfg.SignatureFrames,
+		buckets: make(map[string]*Bucket),
+	}
+	if idx.depth <= 0 {
+		idx.depth = DefaultSignatureFrames
+	}
+
+	if cfg.BucketIndexPath == "" {
+		return idx, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(cfg.BucketIndexPath), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create crash bucket directory: %w", err)
+	}
+
+	if err := idx.load(cfg.BucketIndexPath); err != nil {
+		return nil, err
+	}
+	if err := idx.compact(cfg.BucketIndexPath); err != nil {
+		return nil, err
+	}
+
+	file, err := os.OpenFile(cfg.BucketIndexPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open crash bucket index: %w", err)
+	}
+	idx.file = file
+
+	return idx, nil
+}
+
+func (idx *Index) load(path string) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open crash bucket index: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			// A crash during Add can leave the last occurrence without its
+			// newline. It is not counted, and compact drops it from the
+			// file, so the next Add does not get glued onto it.
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read crash bucket index: %w", err)
+		}
+
+		var entry journalLine
+		if err := json.Unmarshal(bytes.TrimSpace(line), &entry); err != nil {
+			continue
+		}
+		if entry.Bucket != nil {
+			idx.buckets[entry.Bucket.Signature] = entry.Bucket
+			continue
+		}
+		idx.apply(&entry.occurrence)
+	}
+
+	return nil
+}
+
+// compact replaces the journal at path with one line per bucket, so startup
+// does not replay every crash ever reported. It must run before the journal
+// is opened for appending.
+func (idx *Index) compact(path string) error {
+	signatures := make([]string, 0, len(idx.buckets))
+	for signature := range idx.buckets {
+		signatures = append(signatures, signature)
+	}
+	sort.Strings(signatures)
+
+	var buf bytes.Buffer
+	for _, signature := range signatures {
+		line, err := json.Marshal(bucketLine{Bucket: idx.buckets[signature]})
+		if err != nil {
+			return fmt.Errorf("failed to marshal crash bucket: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	tmp := path + ".tmp"
+	if err := fsutil.WriteFileSync(tmp, buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write crash bucket index: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to install crash bucket index: %w", err)
+	}
+	if err := fsutil.SyncDir(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to sync crash bucket directory: %w", err)
+	}
+
+	return nil
+}
+
+// Classify computes the signature of a crash in processTag from the frames a
+// parser extracted, at the configured depth. It returns false when the frames
+// are not usable.
+func (idx *Index) Classify(processTag string, frames []Frame) (Signature, bool) {
+	return Compute(processTag, frames, idx.depth)
+}
+
+// Add records a crash with signature sig from a device running version, and
+// returns the updated bucket.
+func (idx *Index) Add(sig Signature, deviceUID, version string, at time.Time) (*Bucket, error) {
+	occ := &occurrence{
+		Signature:  sig.ID,
+		ProcessTag: sig.ProcessTag,
+		Frames:     sig.Frames,
+		DeviceUID:  deviceUID,
+		Version:    version,
+		Time:       at,
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.file != nil {
+		line, err := json.Marshal(occ)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal crash occurrence: %w", err)
+		}
+		if _, err := idx.file.Write(append(line, '\n')); err != nil {
+			return nil, fmt.Errorf("failed to append to crash bucket index: %w", err)
+		}
+	}
+
+	return idx.apply(occ).clone(), nil
+}
+
+// apply must be called with mu held (or before the index is shared).
+func (idx *Index) apply(occ *occurrence) *Bucket {
+	bucket, exists := idx.buckets[occ.Signature]
+	if !exists {
+		bucket = &Bucket{
+			Signature:  occ.Signature,
+			ProcessTag: occ.ProcessTag,
+			Frames:     occ.Frames,
+			FirstSeen:  occ.Time,
+			LastSeen:   occ.Time,
+		}
+		idx.buckets[occ.Signature] = bucket
+	}
+
+	bucket.Count++
+	if occ.Time.Before(bucket.FirstSeen) {
+		bucket.FirstSeen = occ.Time
+	}
+	if occ.Time.After(bucket.LastSeen) {
+		bucket.LastSeen = occ.Time
+	}
+	bucket.Devices = insertSorted(bucket.Devices, occ.DeviceUID)
+	bucket.Versions = insertSorted(bucket.Versions, occ.Version)
+
+	return bucket
+}
+
+func insertSorted(values []string, value string) []string {
+	if value == "" {
+		return values
+	}
+	i := sort.SearchStrings(values, value)
+	if i < len(values) && values[i] == value {
+		return values
+	}
+	values = append(values, "")
+	copy(values[i+1:], values[i:])
+	values[i] = value
+	return values
+}
+
+func (idx *Index) Get(signature string) (*Bucket, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	bucket, exists := idx.buckets[signature]
+	if !exists {
+		return nil, false
+	}
+	return bucket.clone(), true
+}
+
+// List returns the page of buckets matching q, most recently seen first,
+// along with the total number of matches before pagination.
+func (idx *Index) List(q BucketQuery) ([]*Bucket, int) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultBucketListLimit
+	}
+	if limit > maxBucketListLimit {
+		limit = maxBucketListLimit
+	}
+
+	offset := q.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	var matches []*Bucket
+	for _, bucket := range idx.buckets {
+		if q.ProcessTag != "" && bucket.ProcessTag != q.ProcessTag {
+			continue
+		}
+		matches = append(matches, bucket)
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if !matches[i].LastSeen.Equal(matches[j].LastSeen) {
+			return matches[i].LastSeen.After(matches[j].LastSeen)
+		}
+		return matches[i].Signature < matches[j].Signature
+	})
+
+	var page []*Bucket
+	for i := offset; i < len(matches) && len(page) < limit; i++ {
+		page = append(page, matches[i].clone())
+	}
+
+	return page, len(matches)
+}
+
+func (idx *Index) Close() error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.file == nil {
+		return nil
+	}
+	err := idx.file.Close()
+	idx.file = nil
+	return err
+}
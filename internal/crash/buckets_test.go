@@ -0,0 +1,112 @@
+package crash
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/config"
+)
+
+func configFor(path string, depth int) config.CrashConfig {
+	return config.CrashConfig{SignatureFrames: depth, BucketIndexPath: path}
+}
+
+func TestIndexReloadCompactsJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "buckets.jsonl")
+
+	idx, err := NewIndex(configFor(path, 0))
+	if err != nil {
+		t.Fatalf("NewIndex: %v", err)
+	}
+	sig, _ := idx.Classify("orchagent", []Frame{{Function: "do_task"}})
+	other, _ := idx.Classify("syncd", []Frame{{Function: "sai_create"}})
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	devices := []string{"device-a", "device-b"}
+	for i := 0; i < 5; i++ {
+		if _, err := idx.Add(sig, devices[i%2], "4.0", at.Add(time.Duration(i)*time.Minute)); err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+	}
+	if _, err := idx.Add(other, "device-c", "4.1", at); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	idx.Close()
+
+	reopened, err := NewIndex(configFor(path, 0))
+	if err != nil {
+		t.Fatalf("NewIndex after restart: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 2 {
+		t.Errorf("journal has %d lines after reload, want one per bucket (2)", lines)
+	}
+
+	// Occurrences added after compaction still replay on top of it.
+	if _, err := reopened.Add(sig, "device-c", "4.1", at.Add(time.Hour)); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	reopened.Close()
+
+	again, err := NewIndex(configFor(path, 0))
+	if err != nil {
+		t.Fatalf("NewIndex: %v", err)
+	}
+	defer again.Close()
+
+	bucket, ok := again.Get(sig.ID)
+	if !ok {
+		t.Fatal("bucket lost across restarts")
+	}
+	if bucket.Count != 6 || len(bucket.Devices) != 3 || len(bucket.Versions) != 2 {
+		t.Errorf("bucket = count %d, devices %v, versions %v; want 6, 3 devices, 2 versions", bucket.Count, bucket.Devices, bucket.Versions)
+	}
+	if !bucket.FirstSeen.Equal(at) || !bucket.LastSeen.Equal(at.Add(time.Hour)) {
+		t.Errorf("bucket seen %s..%s, want %s..%s", bucket.FirstSeen, bucket.LastSeen, at, at.Add(time.Hour))
+	}
+}
+
+func TestIndexTornTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "buckets.jsonl")
+
+	idx, err := NewIndex(configFor(path, 0))
+	if err != nil {
+		t.Fatalf("NewIndex: %v", err)
+	}
+	sig, _ := idx.Classify("orchagent", []Frame{{Function: "do_task"}})
+	if _, err := idx.Add(sig, "device-a", "4.0", time.Now()); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	idx.Close()
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString(`{"signature":"` + sig.ID + `","process_tag":"orch`)
+	file.Close()
+
+	reopened, err := NewIndex(configFor(path, 0))
+	if err != nil {
+		t.Fatalf("NewIndex with torn tail: %v", err)
+	}
+	if _, err := reopened.Add(sig, "device-b", "4.0", time.Now()); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	reopened.Close()
+
+	again, err := NewIndex(configFor(path, 0))
+	if err != nil {
+		t.Fatalf("NewIndex: %v", err)
+	}
+	defer again.Close()
+	bucket, _ := again.Get(sig.ID)
+	if bucket == nil || bucket.Count != 2 {
+		t.Errorf("bucket = %+v, want the torn occurrence dropped and the next one kept (count 2)", bucket)
+	}
+}
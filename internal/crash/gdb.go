@@ -0,0 +1,100 @@
+package crash
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// "#3  0x000055d5c8a1b2c3 in swss::Orch::doTask (this=0x55d5c9) at orch.cpp:123"
+	gdbFrame   = regexp.MustCompile(`(?:^|\s)#(\d+)\s+(?:0x[0-9a-fA-F]+\s+in\s+)?(.+)$`)
+	gdbFile    = regexp.MustCompile(`\s+at\s+(\S+):(\d+)\s*$`)
+	gdbLibrary = regexp.MustCompile(`\s+from\s+(\S+)\s*$`)
+
+	// "Program terminated with signal SIGSEGV, Segmentation fault."
+	gdbSignal = regexp.MustCompile(`(?:terminated|received) (?:with )?signal (SIG[A-Z]+)`)
+
+	// "[Current thread is 1 (Thread 0x7f3a2b1c4700 (LWP 1234))]"
+	gdbThread = regexp.MustCompile(`\[Current thread is (\d+) \((?:Thread 0x[0-9a-fA-F]+ )?\(?LWP (\d+)\)?`)
+
+	// "Thread 2 (Thread 0x7f3a2a9c3700 (LWP 1240)):" from "thread apply all bt".
+	gdbThreadHeader = regexp.MustCompile(`^Thread \d+ \(`)
+
+	gdbBareAddress = regexp.MustCompile(`^0x[0-9a-fA-F]*$`)
+)
+
+// gdbParser reads gdb "bt" and "bt full" output, as left by the SONiC core
+// dump handler. The local variables "bt full" prints under each frame are
+// skipped. When several threads were dumped only the first backtrace is used;
+// a backtrace that restarts without a thread header replaces the frame gdb
+// prints when it loads the core. glibc abort messages are picked up too, as
+// symbolized glibc backtraces are rewritten in gdb style.
+type gdbParser struct{}
+
+func (gdbParser) Name() string { return "gdb" }
+
+func (gdbParser) Parse(text string) (*Analysis, bool) {
+	analysis := &Analysis{}
+	nextThread := false
+	for _, line := range lines(text) {
+		if m := gdbFrame.FindStringSubmatch(line); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			if n == 0 && len(analysis.Frames) > 0 {
+				if nextThread {
+					break
+				}
+				analysis.Frames = analysis.Frames[:0]
+			}
+			analysis.Frames = append(analysis.Frames, parseGDBFrame(m[2]))
+			continue
+		}
+		if gdbThreadHeader.MatchString(line) && len(analysis.Frames) > 0 {
+			nextThread = true
+		}
+		if m := gdbSignal.FindStringSubmatch(line); m != nil && analysis.Signal == "" {
+			analysis.Signal = m[1]
+		}
+		if m := gdbThread.FindStringSubmatch(line); m != nil && analysis.Thread == "" {
+			analysis.Thread = m[1] + " (LWP " + m[2] + ")"
+		}
+		if message, ok := abortMessage(line); ok && analysis.Exception == "" {
+			analysis.Exception = message
+		}
+	}
+
+	if len(analysis.Frames) == 0 {
+		return nil, false
+	}
+	if analysis.Exception != "" && analysis.Signal == "" {
+		analysis.Signal = "SIGABRT"
+	}
+	return analysis, true
+}
+
+func parseGDBFrame(rest string) Frame {
+	var frame Frame
+
+	if m := gdbFile.FindStringSubmatchIndex(rest); m != nil {
+		frame.File = rest[m[2]:m[3]]
+		frame.Line, _ = strconv.Atoi(rest[m[4]:m[5]])
+		rest = rest[:m[0]]
+	} else if m := gdbLibrary.FindStringSubmatchIndex(rest); m != nil {
+		frame.Module = rest[m[2]:m[3]]
+		rest = rest[:m[0]]
+	}
+
+	// The argument list is separated from the name by a space, which keeps
+	// names like "operator()" intact.
+	if i := strings.Index(rest, " ("); i >= 0 {
+		rest = rest[:i]
+	}
+	frame.Function = strings.TrimSpace(rest)
+	// A frame cut off inside its address, as at the end of a truncated
+	// report, has no function.
+	if gdbBareAddress.MatchString(frame.Function) {
+		frame.Function = "??"
+	}
+
+	return frame
+}
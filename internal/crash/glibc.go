@@ -0,0 +1,88 @@
+package crash
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// backtrace_symbols: "/usr/bin/orchagent(_ZN4swss4Orch6doTaskEv+0x1a3) [0x55d5c8a1b2c3]"
+	glibcFrame = regexp.MustCompile(`(\S+)\(([^()\s]*)\)\s*\[0x[0-9a-fA-F]+\]`)
+
+	// Messages glibc and libstdc++ print before calling abort().
+	glibcAbort = []*regexp.Regexp{
+		// "orchagent: orch.cpp:123: void swss::Orch::doTask(): Assertion `x != nullptr' failed."
+		regexp.MustCompile(`(Assertion .* failed)\.?\s*$`),
+		// "*** Error in `/usr/bin/orchagent': double free or corruption (fasttop): 0x000055d5c9a0b010 ***"
+		regexp.MustCompile("\\*\\*\\* Error in `[^']*': (.*?)(?:: 0x[0-9a-fA-F]+)? \\*\\*\\*"),
+		// "*** stack smashing detected ***: terminated"
+		regexp.MustCompile(`\*\*\* (.+ detected) \*\*\*`),
+		// "free(): invalid pointer", "malloc(): corrupted top size"
+		regexp.MustCompile(`^\s*((?:free|malloc|realloc|calloc|malloc_consolidate|_int_free|_int_malloc|munmap_chunk|double free)\S*: .+?)\s*$`),
+		// "terminate called after throwing an instance of 'std::out_of_range'"
+		regexp.MustCompile(`terminate called after throwing an instance of '([^']+)'`),
+	}
+
+	// "  what():  map::at"
+	glibcWhat = regexp.MustCompile(`^\s*what\(\):\s+(.*?)\s*$`)
+
+	// Crash handlers print the signal by name or number: "Received signal 11".
+	signalNumber = regexp.MustCompile(`(?i)signal (\d+)\b`)
+)
+
+var signalNames = map[string]string{
+	"4": "SIGILL", "6": "SIGABRT", "7": "SIGBUS", "8": "SIGFPE", "11": "SIGSEGV",
+}
+
+// glibcParser reads what a process writes to stderr when glibc aborts it
+// (assertion failures, heap corruption, fortify checks, uncaught C++
+// exceptions) and backtrace_symbols() output from crash handlers.
+type glibcParser struct{}
+
+func (glibcParser) Name() string { return "glibc" }
+
+func (glibcParser) Parse(text string) (*Analysis, bool) {
+	analysis := &Analysis{}
+	aborted := false
+	for _, line := range lines(text) {
+		if m := glibcFrame.FindStringSubmatch(line); m != nil {
+			function, _, _ := strings.Cut(m[2], "+")
+			analysis.Frames = append(analysis.Frames, Frame{Function: function, Module: m[1]})
+			continue
+		}
+		if m := glibcWhat.FindStringSubmatch(line); m != nil && aborted {
+			analysis.Exception += ": " + m[1]
+			continue
+		}
+		if message, ok := abortMessage(line); ok && analysis.Exception == "" {
+			analysis.Exception = message
+			aborted = true
+		}
+		if analysis.Signal == "" {
+			if m := signalName.FindString(line); m != "" {
+				analysis.Signal = m
+			} else if m := signalNumber.FindStringSubmatch(line); m != nil {
+				analysis.Signal = signalNames[m[1]]
+			}
+		}
+	}
+
+	if len(analysis.Frames) == 0 && !aborted {
+		return nil, false
+	}
+	if aborted && analysis.Signal == "" {
+		analysis.Signal = "SIGABRT"
+	}
+	return analysis, true
+}
+
+// abortMessage returns the reason from a message glibc or libstdc++ printed
+// before aborting.
+func abortMessage(line string) (string, bool) {
+	for _, re := range glibcAbort {
+		if m := re.FindStringSubmatch(line); m != nil {
+			return m[1], true
+		}
+	}
+	return "", false
+}
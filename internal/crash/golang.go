@@ -0,0 +1,70 @@
+package crash
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	goPanic     = regexp.MustCompile(`^(?:panic|fatal error): (.+)$`)
+	goGoroutine = regexp.MustCompile(`^goroutine (\d+) \[`)
+	goLocation  = regexp.MustCompile(`^\t(\S+):(\d+)`)
+)
+
+// goParser reads Go panics and fatal runtime errors. Frames come from the
+// first goroutine dumped, which is the one that panicked.
+type goParser struct{}
+
+func (goParser) Name() string { return "go" }
+
+func (goParser) Parse(text string) (*Analysis, bool) {
+	analysis := &Analysis{}
+	recognized := false
+	inTrace := false
+	for _, line := range lines(text) {
+		if inTrace {
+			if line == "" || strings.HasPrefix(line, "created by ") {
+				break
+			}
+			if m := goLocation.FindStringSubmatch(line); m != nil {
+				if n := len(analysis.Frames); n > 0 {
+					analysis.Frames[n-1].File = m[1]
+					analysis.Frames[n-1].Line, _ = strconv.Atoi(m[2])
+				}
+				continue
+			}
+			analysis.Frames = append(analysis.Frames, Frame{Function: goFunction(line)})
+			continue
+		}
+
+		if m := goPanic.FindStringSubmatch(line); m != nil && analysis.Exception == "" {
+			analysis.Exception = m[1]
+			recognized = true
+			continue
+		}
+		if m := goGoroutine.FindStringSubmatch(line); m != nil && recognized {
+			analysis.Thread = "goroutine " + m[1]
+			inTrace = true
+			continue
+		}
+		if m := signalName.FindString(line); m != "" && recognized && analysis.Signal == "" {
+			analysis.Signal = m
+		}
+	}
+
+	if !recognized || analysis.Thread == "" {
+		return nil, false
+	}
+	return analysis, true
+}
+
+// goFunction drops the argument list from "main.(*Server).handle(0x0, {0x1, 0x2})".
+// Arguments never contain parentheses, so it starts at the last one.
+func goFunction(line string) string {
+	name := strings.TrimSpace(line)
+	if i := strings.LastIndex(name, "("); i > 0 && strings.HasSuffix(name, ")") {
+		name = name[:i]
+	}
+	return name
+}
@@ -0,0 +1,81 @@
+package crash
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// Lines come from dmesg or syslog: "Oct 17 10:00:00 sonic kernel: [ 1234.567890] ...".
+	kernelPrefix = regexp.MustCompile(`^(?:.*?kernel:\s*)?(?:<\d+>)?(?:\[\s*\d+\.\d+\]\s?)?`)
+
+	kernelMessage = regexp.MustCompile(`^(BUG: .+|kernel BUG at .+|general protection fault.*|Unable to handle kernel .+|Kernel panic - not syncing: .+)$`)
+
+	// "CPU: 3 PID: 1234 Comm: syncd Tainted: P           OE     5.10.0-18-2-amd64 #1"
+	kernelTask  = regexp.MustCompile(`CPU: (\d+) (?:UID: \d+ )?PID: (\d+) Comm: (\S+)`)
+	kernelTaint = regexp.MustCompile(`Tainted: ([A-Z][A-Z ]*?)\s+\d`)
+
+	// "RIP: 0010:sx_core_ioctl+0x1a/0x30 [sx_core]" on x86, "pc : ..." on arm64.
+	kernelIP = regexp.MustCompile(`^(?:RIP: [0-9a-f]{4}:|pc : )(\S+?)\+0x[0-9a-f]+/0x[0-9a-f]+(?: \[(\S+)\])?`)
+
+	// " ? sx_core_ioctl+0x1a/0x30 [sx_core]"; frames marked "?" are guesses.
+	kernelFrame = regexp.MustCompile(`^\s*(\? )?(\S+?)\+0x[0-9a-f]+/0x[0-9a-f]+(?: \[(\S+)\])?`)
+)
+
+// kernelParser reads Linux kernel oops, BUG and panic logs. Taint carries the
+// taint flags, e.g. "POE" for a kernel running proprietary out-of-tree
+// modules such as ASIC SDK drivers.
+type kernelParser struct{}
+
+func (kernelParser) Name() string { return "kernel" }
+
+func (kernelParser) Parse(text string) (*Analysis, bool) {
+	analysis := &Analysis{}
+	recognized := false
+	inTrace, traced := false, false
+	for _, line := range lines(text) {
+		line = kernelPrefix.ReplaceAllString(line, "")
+
+		if inTrace {
+			if m := kernelFrame.FindStringSubmatch(line); m != nil {
+				if m[1] == "" {
+					analysis.Frames = append(analysis.Frames, Frame{Function: m[2], Module: m[3]})
+				}
+				continue
+			}
+			if s := strings.TrimSpace(line); strings.HasPrefix(s, "<") && strings.HasSuffix(s, ">") {
+				// <TASK>, <IRQ> and friends.
+				continue
+			}
+			inTrace = false
+		}
+
+		switch {
+		case strings.HasPrefix(line, "Call Trace:") || line == "Call trace:":
+			// Only the first trace describes the fault.
+			inTrace = !traced
+			traced, recognized = true, true
+		case kernelMessage.MatchString(line):
+			if analysis.Exception == "" {
+				analysis.Exception = line
+			}
+			recognized = true
+		}
+
+		if m := kernelIP.FindStringSubmatch(line); m != nil && len(analysis.Frames) == 0 {
+			analysis.Frames = append(analysis.Frames, Frame{Function: m[1], Module: m[2]})
+		}
+		if m := kernelTask.FindStringSubmatch(line); m != nil && analysis.Thread == "" {
+			analysis.Thread = fmt.Sprintf("%s (PID %s, CPU %s)", m[3], m[2], m[1])
+		}
+		if m := kernelTaint.FindStringSubmatch(line); m != nil && analysis.Taint == "" {
+			analysis.Taint = strings.ReplaceAll(m[1], " ", "")
+		}
+	}
+
+	if !recognized {
+		return nil, false
+	}
+	return analysis, true
+}
@@ -0,0 +1,97 @@
+// Package crash makes sense of the crash artifacts devices upload. Parsers
+// extract structured fields (signal, faulting thread, frames, exception and
+// kernel taint) from the formats SONiC produces, and crash reports that share
+// a root cause are grouped into buckets by a signature that does not vary
+// between devices or runs.
+package crash
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Frame is one stack frame, innermost first. Function is empty (or "??")
+// when the frame could not be symbolized.
+type Frame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line,omitempty"`
+}
+
+func (f Frame) Symbolized() bool {
+	return f.Function != "" && f.Function != "??"
+}
+
+// Analysis is what a parser extracted from a crash artifact. Fields a format
+// does not carry are left empty.
+type Analysis struct {
+	Format    string
+	Signal    string
+	Thread    string
+	Exception string
+	Taint     string
+	Frames    []Frame
+}
+
+// TopFrame returns the innermost symbolized frame that is not part of the
+// signal and abort machinery, normalized as in signatures.
+func (a *Analysis) TopFrame() string {
+	for _, frame := range a.Frames {
+		if !frame.Symbolized() {
+			continue
+		}
+		if function := normalizeFunction(frame.Function); function != "" && !abortFrames[function] {
+			return function
+		}
+	}
+	return ""
+}
+
+// Parser recognizes one crash artifact format.
+type Parser interface {
+	Name() string
+	// Parse returns false when text is not in the parser's format.
+	Parse(text string) (*Analysis, bool)
+}
+
+// Registry tries its parsers in order; the first to recognize a text wins.
+type Registry struct {
+	parsers []Parser
+}
+
+func NewRegistry(parsers ...Parser) *Registry {
+	return &Registry{parsers: parsers}
+}
+
+// DefaultRegistry knows Go panics, Python tracebacks, Linux kernel oopses and
+// panics, gdb backtraces and glibc abort and backtrace output. The formats
+// that are easiest to tell apart come first; gdb and glibc output also turn up
+// inside the others.
+func DefaultRegistry() *Registry {
+	return NewRegistry(goParser{}, pythonParser{}, kernelParser{}, gdbParser{}, glibcParser{})
+}
+
+func (r *Registry) Register(p Parser) {
+	r.parsers = append(r.parsers, p)
+}
+
+func (r *Registry) Parse(text string) (*Analysis, bool) {
+	for _, p := range r.parsers {
+		if analysis, ok := p.Parse(text); ok {
+			analysis.Format = p.Name()
+			return analysis, true
+		}
+	}
+	return nil, false
+}
+
+var signalName = regexp.MustCompile(`\bSIG[A-Z]{2,6}\b`)
+
+func lines(text string) []string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, "\r")
+	}
+	return lines
+}
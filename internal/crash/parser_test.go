@@ -0,0 +1,225 @@
+package crash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRegistryParse(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		// format is the parser that must win; empty when none may.
+		format    string
+		signal    string
+		thread    string
+		exception string
+		taint     string
+		functions []string
+		topFrame  string
+	}{
+		{
+			name: "go panic with several goroutines",
+			text: `panic: runtime error: invalid memory address or nil pointer dereference
+[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x4a1b2c]
+
+goroutine 17 [running]:
+main.(*Server).handle(0x0, {0xc000010000, 0x3})
+	/src/server.go:42 +0x1c
+main.worker(...)
+	/src/worker.go:10
+created by main.start in goroutine 1
+	/src/main.go:20 +0x5a
+
+goroutine 1 [chan receive]:
+main.main()
+	/src/main.go:25 +0x88
+
+goroutine 18 [select]:
+main.ticker()
+	/src/main.go:31 +0x40
+`,
+			format:    "go",
+			signal:    "SIGSEGV",
+			thread:    "goroutine 17",
+			exception: "runtime error: invalid memory address or nil pointer dereference",
+			functions: []string{"main.(*Server).handle", "main.worker"},
+			topFrame:  "main.(*Server).handle",
+		},
+		{
+			name: "gdb with inlined frames",
+			text: `Program terminated with signal SIGSEGV, Segmentation fault.
+#0  0x00007f3a2b1c4e97 in memcpy () from /lib/x86_64-linux-gnu/libc.so.6
+#1  parse_entry (entry=0x0) at parser.c:88
+#2  handle_request (req=0x55d5c9a0b010) at server.c:120
+#3  0x000055d5c8a1b2c3 in main () at main.c:14
+`,
+			format:    "gdb",
+			signal:    "SIGSEGV",
+			functions: []string{"memcpy", "parse_entry", "handle_request", "main"},
+			topFrame:  "memcpy",
+		},
+		{
+			name: "gdb with glibc assertion printed before it",
+			text: "orchagent: orch.cpp:123: void swss::Orch::doTask(): Assertion `x != nullptr' failed.\n" + `Core was generated by ` + "`/usr/bin/orchagent'" + `.
+Program terminated with signal SIGABRT, Aborted.
+[Current thread is 1 (Thread 0x7f3a2b1c4700 (LWP 1234))]
+#0  __GI_raise (sig=sig@entry=6) at ../sysdeps/unix/sysv/linux/raise.c:50
+#1  0x00007f3a2b1c6801 in __GI_abort () at abort.c:79
+#2  0x000055d5c8a1b2c3 in swss::Orch::doTask (this=0x55d5c9) at orch.cpp:123
+
+Thread 2 (Thread 0x7f3a2a9c3700 (LWP 1240)):
+#0  0x00007f3a2b2a1d2f in epoll_wait () from /lib/x86_64-linux-gnu/libc.so.6
+`,
+			format:    "gdb",
+			signal:    "SIGABRT",
+			thread:    "1 (LWP 1234)",
+			exception: "Assertion `x != nullptr' failed",
+			functions: []string{"__GI_raise", "__GI_abort", "swss::Orch::doTask"},
+			topFrame:  "swss::Orch::doTask",
+		},
+		{
+			name: "kernel oops whose version line looks like a gdb frame",
+			text: `[ 1234.567890] BUG: kernel NULL pointer dereference, address: 0000000000000010
+[ 1234.567900] CPU: 3 PID: 1234 Comm: syncd Tainted: P           OE     5.10.0-18-2-amd64 #1 Debian 5.10.140-1
+[ 1234.567910] RIP: 0010:sx_core_ioctl+0x1a/0x30 [sx_core]
+[ 1234.567920] Call Trace:
+[ 1234.567930]  <TASK>
+[ 1234.567940]  ? show_regs+0x1a/0x30
+[ 1234.567950]  sx_core_dispatch+0x44/0x90 [sx_core]
+[ 1234.567960]  __x64_sys_ioctl+0x8b/0xc0
+[ 1234.567970]  </TASK>
+`,
+			format:    "kernel",
+			thread:    "syncd (PID 1234, CPU 3)",
+			exception: "BUG: kernel NULL pointer dereference, address: 0000000000000010",
+			taint:     "POE",
+			functions: []string{"sx_core_ioctl", "sx_core_dispatch", "__x64_sys_ioctl"},
+			topFrame:  "sx_core_ioctl",
+		},
+		{
+			name: "python traceback logged next to a glibc backtrace line",
+			text: `Exception in thread worker:
+Traceback (most recent call last):
+  File "/usr/lib/python3.9/threading.py", line 954, in _bootstrap_inner
+    self.run()
+  File "/usr/local/bin/portsyncd", line 88, in run
+    handle(port)
+KeyError: 'Ethernet0'
+/usr/bin/python3(+0x1c2d3) [0x55d5c8a1b2c3]
+`,
+			format:    "python",
+			thread:    "worker",
+			exception: "KeyError: 'Ethernet0'",
+			functions: []string{"run", "_bootstrap_inner"},
+			topFrame:  "run",
+		},
+		{
+			name: "python chained exceptions",
+			text: `Traceback (most recent call last):
+  File "/usr/local/bin/config", line 10, in load
+    open(path)
+FileNotFoundError: [Errno 2] No such file or directory: 'config_db.json'
+
+During handling of the above exception, another exception occurred:
+
+Traceback (most recent call last):
+  File "/usr/local/bin/config", line 20, in main
+    load()
+RuntimeError: cannot load config
+`,
+			format:    "python",
+			exception: "RuntimeError: cannot load config",
+			functions: []string{"main"},
+			topFrame:  "main",
+		},
+		{
+			name: "glibc uncaught exception with backtrace",
+			text: `terminate called after throwing an instance of 'std::out_of_range'
+  what():  map::at
+/usr/bin/orchagent(_ZN4swss4Orch6doTaskEv+0x1a3) [0x55d5c8a1b2c3]
+/usr/bin/orchagent(+0x1c2d3) [0x55d5c8a1c2d3]
+`,
+			format:    "glibc",
+			signal:    "SIGABRT",
+			exception: "std::out_of_range: map::at",
+			functions: []string{"_ZN4swss4Orch6doTaskEv", ""},
+			topFrame:  "_ZN4swss4Orch6doTaskEv",
+		},
+		{
+			name: "truncated gdb frame",
+			text: `Program terminated with signal SIGSEGV, Segmentation fault.
+#0  0x00007f3a2b1c4e97 in memcpy () from /lib/x86_64-linux-gnu/libc.so.6
+#1  0x000055d5c8a1b2c3 in parse_entry (entry=0x0) at pars`,
+			format:    "gdb",
+			signal:    "SIGSEGV",
+			functions: []string{"memcpy", "parse_entry"},
+			topFrame:  "memcpy",
+		},
+		{
+			name:      "gdb frame cut inside its address",
+			text:      "#0  0x00007f3a2b1c4e97 in raise () from /lib/x86_64-linux-gnu/libc.so.6\n#1  0x000055d5",
+			format:    "gdb",
+			functions: []string{"raise", "??"},
+		},
+		{
+			name: "truncated python traceback",
+			text: `Traceback (most recent call last):
+  File "/usr/local/bin/portsyncd", line 88, in run
+    handle(port)
+  File "/usr/local/bin/portsyncd", line 40, in handle
+`,
+			format:    "python",
+			functions: []string{"handle", "run"},
+			topFrame:  "handle",
+		},
+		{
+			name: "go panic cut before its goroutine",
+			text: "panic: boom\n\ngorout",
+		},
+		{
+			name: "kernel message without a trace",
+			text: "[ 1.000000] Kernel panic - not syncing: Fatal exception\n",
+			// The panic line alone is enough to recognize the format.
+			format:    "kernel",
+			exception: "Kernel panic - not syncing: Fatal exception",
+		},
+		{
+			name: "plain log",
+			text: "Oct 17 10:10:10 sonic swss#orchagent: :- main: Orchagent started\n",
+		},
+		{
+			name: "empty",
+			text: "",
+		},
+	}
+
+	registry := DefaultRegistry()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			analysis, ok := registry.Parse(tc.text)
+			if tc.format == "" {
+				if ok {
+					t.Fatalf("parsed as %s, want no parser to match", analysis.Format)
+				}
+				return
+			}
+			if !ok {
+				t.Fatalf("no parser matched, want %s", tc.format)
+			}
+
+			var functions []string
+			for _, frame := range analysis.Frames {
+				functions = append(functions, frame.Function)
+			}
+			got := []string{analysis.Format, analysis.Signal, analysis.Thread, analysis.Exception, analysis.Taint, analysis.TopFrame()}
+			want := []string{tc.format, tc.signal, tc.thread, tc.exception, tc.taint, tc.topFrame}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("format, signal, thread, exception, taint, top frame = %q, want %q", got, want)
+			}
+			if !reflect.DeepEqual(functions, tc.functions) {
+				t.Errorf("frames = %q, want %q", functions, tc.functions)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package crash
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	pythonFrame  = regexp.MustCompile(`^\s+File "([^"]+)", line (\d+), in (.+)$`)
+	pythonThread = regexp.MustCompile(`^Exception in thread (\S+?)(?: \(.*\))?:$`)
+)
+
+// pythonParser reads Python tracebacks, as printed by sonic-utilities and
+// the other Python daemons. With chained exceptions the last traceback is the
+// one that was not handled, so it wins.
+type pythonParser struct{}
+
+func (pythonParser) Name() string { return "python" }
+
+func (pythonParser) Parse(text string) (*Analysis, bool) {
+	analysis := &Analysis{}
+	recognized := false
+	inTrace := false
+	var frames []Frame
+	for _, line := range lines(text) {
+		if strings.HasPrefix(strings.TrimSpace(line), "Traceback (most recent call last):") {
+			recognized, inTrace = true, true
+			frames = nil
+			continue
+		}
+		if m := pythonThread.FindStringSubmatch(line); m != nil {
+			analysis.Thread = m[1]
+			continue
+		}
+		if !inTrace {
+			continue
+		}
+
+		if m := pythonFrame.FindStringSubmatch(line); m != nil {
+			n, _ := strconv.Atoi(m[2])
+			frames = append(frames, Frame{Function: m[3], File: m[1], Line: n})
+			continue
+		}
+		// Source lines and caret markers are indented; the first line that
+		// is not ends the traceback with the exception.
+		if line != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "\t") {
+			analysis.Exception = strings.TrimSpace(line)
+			analysis.Frames = frames
+			inTrace = false
+		}
+	}
+
+	if !recognized {
+		return nil, false
+	}
+	if inTrace {
+		analysis.Frames = frames
+	}
+	// Tracebacks list the innermost frame last.
+	for i, j := 0, len(analysis.Frames)-1; i < j; i, j = i+1, j-1 {
+		analysis.Frames[i], analysis.Frames[j] = analysis.Frames[j], analysis.Frames[i]
+	}
+	return analysis, true
+}
@@ -0,0 +1,100 @@
+package crash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+)
+
+// DefaultSignatureFrames is how many frames make up a signature by default.
+const DefaultSignatureFrames = 5
+
+// Signature identifies a crash bucket. ID is derived from the normalized
+// process tag and frames, which are kept for display.
+type Signature struct {
+	ID         string
+	ProcessTag string
+	Frames     []string
+}
+
+// abortFrames are the signal and abort machinery every crash passes through;
+// they say nothing about which crash it was.
+var abortFrames = map[string]bool{
+	"<signal handler called>":                true,
+	"raise":                                  true,
+	"abort":                                  true,
+	"gsignal":                                true,
+	"pthread_kill":                           true,
+	"__GI_raise":                             true,
+	"__GI_abort":                             true,
+	"__pthread_kill_implementation":          true,
+	"__pthread_kill_internal":                true,
+	"__assert_fail":                          true,
+	"__assert_fail_base":                     true,
+	"__restore_rt":                           true,
+	"__libc_message":                         true,
+	"__fortify_fail":                         true,
+	"__stack_chk_fail":                       true,
+	"__gnu_cxx::__verbose_terminate_handler": true,
+	"std::terminate":                         true,
+	"__cxxabiv1::__terminate":                true,
+}
+
+var (
+	processPID   = regexp.MustCompile(`\[\d+\]|\(\d+\)|[.:]\d{4,}$`)
+	hexNumber    = regexp.MustCompile(`0x[0-9a-fA-F]+`)
+	cloneSuffix  = regexp.MustCompile(`(\.(isra|constprop|part|cold|lto_priv)(\.\d+)?)+$`)
+	versionedSym = regexp.MustCompile(`@.*$`)
+)
+
+// Compute builds the signature of a crash in processTag from the top depth
+// symbolized frames, skipping abort machinery. It returns false when no frame
+// is symbolized, since such a signature would lump unrelated crashes together.
+func Compute(processTag string, frames []Frame, depth int) (Signature, bool) {
+	if depth <= 0 {
+		depth = DefaultSignatureFrames
+	}
+
+	sig := Signature{ProcessTag: NormalizeProcessTag(processTag)}
+	for _, frame := range frames {
+		if len(sig.Frames) == depth {
+			break
+		}
+		if !frame.Symbolized() {
+			continue
+		}
+		function := normalizeFunction(frame.Function)
+		if function == "" || abortFrames[function] {
+			continue
+		}
+		sig.Frames = append(sig.Frames, function)
+	}
+
+	if len(sig.Frames) == 0 {
+		return Signature{}, false
+	}
+
+	sum := sha256.Sum256([]byte(sig.ProcessTag + "\n" + strings.Join(sig.Frames, "\n")))
+	sig.ID = hex.EncodeToString(sum[:8])
+	return sig, true
+}
+
+// NormalizeProcessTag drops PIDs: a bracketed or parenthesized number
+// anywhere, as in "orchagent[123]" or "orchagent(123)", and a trailing number
+// of at least four digits after a dot or colon, as in "orchagent.1234". Short
+// dotted numbers are kept, since they are usually versions, as in "python3.9"
+// or "ld-2.31".
+func NormalizeProcessTag(tag string) string {
+	return strings.TrimSpace(processPID.ReplaceAllString(tag, ""))
+}
+
+// normalizeFunction drops offsets, addresses, symbol versions and compiler
+// clone suffixes, which differ between builds of the same code.
+func normalizeFunction(function string) string {
+	function, _, _ = strings.Cut(function, "+0x")
+	function = versionedSym.ReplaceAllString(function, "")
+	function = cloneSuffix.ReplaceAllString(function, "")
+	function = hexNumber.ReplaceAllString(function, "")
+	return strings.TrimSpace(function)
+}
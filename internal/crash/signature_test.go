@@ -0,0 +1,142 @@
+package crash
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeProcessTag(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want string
+	}{
+		{"orchagent", "orchagent"},
+		{"orchagent[1234]", "orchagent"},
+		{"orchagent(77)", "orchagent"},
+		{"syncd[12]", "syncd"},
+		{"orchagent.1234", "orchagent"},
+		{"orchagent:123456", "orchagent"},
+		{" bgpd[9876] ", "bgpd"},
+		{"python3.9", "python3.9"},
+		{"python3.11", "python3.11"},
+		{"ld-2.31", "ld-2.31"},
+		{"libc.so.6", "libc.so.6"},
+		{"teamd.123", "teamd.123"},
+	}
+
+	for _, tc := range tests {
+		if got := NormalizeProcessTag(tc.tag); got != tc.want {
+			t.Errorf("NormalizeProcessTag(%q) = %q, want %q", tc.tag, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeFunction(t *testing.T) {
+	tests := []struct {
+		function string
+		want     string
+	}{
+		{"do_task", "do_task"},
+		{"do_task+0x1a", "do_task"},
+		{"memcpy@GLIBC_2.14", "memcpy"},
+		{"memcpy@@GLIBC_2.2.5", "memcpy"},
+		{"parse_entry.isra.0", "parse_entry"},
+		{"parse_entry.constprop.3.cold", "parse_entry"},
+		{"parse_entry.part.1", "parse_entry"},
+		{"handler.lto_priv.0", "handler"},
+		{"swss::Orch::doTask(0x55d0c8a0)", "swss::Orch::doTask()"},
+		{"  padded  ", "padded"},
+		{"+0x10", ""},
+	}
+
+	for _, tc := range tests {
+		if got := normalizeFunction(tc.function); got != tc.want {
+			t.Errorf("normalizeFunction(%q) = %q, want %q", tc.function, got, tc.want)
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	idx, err := NewIndex(configFor("", 3))
+	if err != nil {
+		t.Fatalf("NewIndex: %v", err)
+	}
+
+	frames := func(functions ...string) []Frame {
+		var out []Frame
+		for _, f := range functions {
+			out = append(out, Frame{Function: f})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		processTag string
+		frames     []Frame
+		wantTag    string
+		wantFrames []string
+		wantOK     bool
+	}{
+		{
+			name:       "abort machinery skipped",
+			processTag: "orchagent[1234]",
+			frames:     frames("__GI_raise", "__GI_abort", "<signal handler called>", "do_task", "main"),
+			wantTag:    "orchagent",
+			wantFrames: []string{"do_task", "main"},
+			wantOK:     true,
+		},
+		{
+			name:       "unsymbolized frames skipped",
+			processTag: "syncd",
+			frames:     frames("??", "", "sai_create+0x40", "main"),
+			wantTag:    "syncd",
+			wantFrames: []string{"sai_create", "main"},
+			wantOK:     true,
+		},
+		{
+			name:       "depth limit",
+			processTag: "bgpd",
+			frames:     frames("a", "b", "c", "d"),
+			wantTag:    "bgpd",
+			wantFrames: []string{"a", "b", "c"},
+			wantOK:     true,
+		},
+		{
+			name:       "nothing symbolized",
+			processTag: "orchagent",
+			frames:     frames("??", "??", "abort"),
+			wantOK:     false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sig, ok := idx.Classify(tc.processTag, tc.frames)
+			if ok != tc.wantOK {
+				t.Fatalf("Classify ok = %v, want %v", ok, tc.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if sig.ProcessTag != tc.wantTag || !reflect.DeepEqual(sig.Frames, tc.wantFrames) {
+				t.Errorf("Classify = %q %v, want %q %v", sig.ProcessTag, sig.Frames, tc.wantTag, tc.wantFrames)
+			}
+			if len(sig.ID) != 16 {
+				t.Errorf("signature ID %q is not 16 hex digits", sig.ID)
+			}
+		})
+	}
+
+	// Builds that differ only in PIDs, offsets and clone suffixes share a
+	// bucket; a different process does not.
+	a, _ := idx.Classify("orchagent[1]", frames("do_task+0x10", "run.isra.0"))
+	b, _ := idx.Classify("orchagent[2]", frames("do_task+0x24", "run"))
+	c, _ := idx.Classify("syncd", frames("do_task", "run"))
+	if a.ID != b.ID {
+		t.Errorf("same crash got signatures %s and %s", a.ID, b.ID)
+	}
+	if a.ID == c.ID {
+		t.Errorf("crashes in different processes share signature %s", a.ID)
+	}
+}
@@ -0,0 +1,35 @@
+// Package fsutil holds the file helpers shared by the on-disk journals and
+// snapshots, which replace files by writing a temporary copy and renaming it
+// into place.
+package fsutil
+
+import "os"
+
+// WriteFileSync writes data to path and fsyncs it before closing, so that a
+// rename of path afterwards never exposes a partially written file.
+func WriteFileSync(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// SyncDir fsyncs a directory, which makes renames and removals in it
+// durable.
+func SyncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/vtapaskar/brahma/internal/crash"
+	"github.com/vtapaskar/brahma/internal/metrics"
+	"github.com/vtapaskar/brahma/internal/registry"
+	"github.com/vtapaskar/brahma/internal/symbols"
+	brahmav1 "github.com/vtapaskar/brahma/proto/brahma/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) RotateDeviceToken(ctx context.Context, req *brahmav1.RotateDeviceTokenRequest) (*brahmav1.RotateDeviceTokenResponse, error) {
+	token, device, err := s.registry.RotateToken(req.Uid)
+	if errors.Is(err, registry.ErrDeviceNotFound) {
+		return nil, status.Error(codes.NotFound, "device not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rotate device token: %v", err)
+	}
+
+	return &brahmav1.RotateDeviceTokenResponse{
+		Uid:      device.UID,
+		Token:    token,
+		IssuedAt: timestamppb.New(device.TokenIssuedAt),
+	}, nil
+}
+
+func (s *Server) RevokeDeviceToken(ctx context.Context, req *brahmav1.RevokeDeviceTokenRequest) (*brahmav1.RevokeDeviceTokenResponse, error) {
+	err := s.registry.RevokeToken(req.Uid)
+	if errors.Is(err, registry.ErrDeviceNotFound) {
+		return nil, status.Error(codes.NotFound, "device not found")
+	}
+	if err != nil {
+		s.logger.Error("Failed to revoke device token", zap.String("uid", req.Uid), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to revoke device token: %v", err)
+	}
+
+	return &brahmav1.RevokeDeviceTokenResponse{
+		Uid:    req.Uid,
+		Status: "revoked",
+	}, nil
+}
+
+func (s *Server) ListCrashBuckets(ctx context.Context, req *brahmav1.ListCrashBucketsRequest) (*brahmav1.ListCrashBucketsResponse, error) {
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
+	}
+
+	buckets, total := s.collector.ListCrashBuckets(crash.BucketQuery{
+		ProcessTag: req.ProcessTag,
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset),
+	})
+
+	resp := &brahmav1.ListCrashBucketsResponse{
+		Total: int32(total),
+	}
+
+	for _, b := range buckets {
+		resp.Buckets = append(resp.Buckets, crashBucketResponse(b))
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetCrashBucket(ctx context.Context, req *brahmav1.GetCrashBucketRequest) (*brahmav1.CrashBucket, error) {
+	if req.Signature == "" {
+		return nil, status.Error(codes.InvalidArgument, "signature is required")
+	}
+
+	bucket, exists := s.collector.GetCrashBucket(req.Signature)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "crash bucket not found")
+	}
+
+	return crashBucketResponse(bucket), nil
+}
+
+func crashBucketResponse(b *crash.Bucket) *brahmav1.CrashBucket {
+	return &brahmav1.CrashBucket{
+		Signature:  b.Signature,
+		ProcessTag: b.ProcessTag,
+		Frames:     b.Frames,
+		FirstSeen:  timestamppb.New(b.FirstSeen),
+		LastSeen:   timestamppb.New(b.LastSeen),
+		Count:      int64(b.Count),
+		DeviceUids: b.Devices,
+		Versions:   b.Versions,
+	}
+}
+
+// UploadSymbols buffers the bundle in a temporary file before storing it, so
+// the stream is fully received whether or not the bundle turns out valid.
+func (s *Server) UploadSymbols(stream brahmav1.AdminService_UploadSymbolsServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	data, ok := first.Data.(*brahmav1.SymbolBundleChunk_Metadata)
+	if !ok || data.Metadata == nil {
+		return status.Error(codes.InvalidArgument, "metadata must be the first message")
+	}
+	metadata := data.Metadata
+	if metadata.Version == "" || metadata.ProcessTag == "" {
+		return status.Error(codes.InvalidArgument, "version and process_tag are required")
+	}
+
+	tmp, err := os.CreateTemp("", "brahma-symbols-*")
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to buffer symbol bundle: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		data, ok := chunk.Data.(*brahmav1.SymbolBundleChunk_Chunk)
+		if !ok {
+			return status.Error(codes.InvalidArgument, "metadata may only be sent once")
+		}
+		if _, err := tmp.Write(data.Chunk); err != nil {
+			return status.Errorf(codes.Internal, "failed to buffer symbol bundle: %v", err)
+		}
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return status.Errorf(codes.Internal, "failed to buffer symbol bundle: %v", err)
+	}
+
+	files, err := s.collector.StoreSymbols(metadata.Version, metadata.ProcessTag, metadata.Filename, tmp)
+	switch {
+	case errors.Is(err, metrics.ErrSymbolsDisabled):
+		return status.Error(codes.FailedPrecondition, "symbol store is not configured")
+	case errors.Is(err, symbols.ErrInvalidBundle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		s.logger.Error("Failed to store symbol bundle",
+			zap.String("version", metadata.Version),
+			zap.String("process_tag", metadata.ProcessTag),
+			zap.Error(err),
+		)
+		return status.Errorf(codes.Internal, "failed to store symbol bundle: %v", err)
+	}
+
+	s.logger.Info("Symbol bundle stored",
+		zap.String("version", metadata.Version),
+		zap.String("process_tag", metadata.ProcessTag),
+		zap.Int("files", len(files)),
+	)
+
+	return stream.SendAndClose(&brahmav1.UploadSymbolsResponse{
+		Version:    metadata.Version,
+		ProcessTag: metadata.ProcessTag,
+		Files:      files,
+	})
+}
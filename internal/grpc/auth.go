@@ -0,0 +1,233 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"github.com/vtapaskar/brahma/internal/registry"
+	brahmav1 "github.com/vtapaskar/brahma/proto/brahma/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type authPolicy int
+
+const (
+	authNone authPolicy = iota
+	// authDevice requires a device token for the UID in the request, or the
+	// admin token.
+	authDevice
+	authAdmin
+)
+
+// identity is the authenticated caller of an RPC: either the administrator
+// or the device its token was issued to.
+type identity struct {
+	admin     bool
+	deviceUID string
+}
+
+type identityKey struct{}
+
+func identityFromContext(ctx context.Context) (identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(identity)
+	return id, ok
+}
+
+func methodPolicy(fullMethod string) authPolicy {
+	service := func(desc grpc.ServiceDesc) bool {
+		return strings.HasPrefix(fullMethod, "/"+desc.ServiceName+"/")
+	}
+
+	switch {
+	case service(brahmav1.AdminService_ServiceDesc):
+		return authAdmin
+	case service(brahmav1.MetricsService_ServiceDesc), service(brahmav1.LogService_ServiceDesc):
+		return authDevice
+	case fullMethod == brahmav1.DeviceService_Heartbeat_FullMethodName,
+		fullMethod == brahmav1.DeviceService_Unregister_FullMethodName:
+		return authDevice
+	}
+	return authNone
+}
+
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func (s *Server) isAdminToken(token string) bool {
+	return s.config.AdminToken != "" && token != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(s.config.AdminToken)) == 1
+}
+
+func (s *Server) authenticate(ctx context.Context) (identity, error) {
+	token := bearerToken(ctx)
+	if token == "" {
+		return identity{}, status.Error(codes.Unauthenticated, "bearer token required")
+	}
+
+	if s.isAdminToken(token) {
+		return identity{admin: true}, nil
+	}
+
+	device, err := s.registry.AuthenticateToken(token)
+	if errors.Is(err, registry.ErrTokenRevoked) {
+		return identity{}, status.Error(codes.Unauthenticated, "device token has been revoked")
+	}
+	if err != nil {
+		return identity{}, status.Error(codes.Unauthenticated, "invalid device token")
+	}
+
+	return identity{deviceUID: device.UID}, nil
+}
+
+func (s *Server) authorizeCall(ctx context.Context, fullMethod string) (identity, error) {
+	policy := methodPolicy(fullMethod)
+	if policy == authNone {
+		return identity{}, nil
+	}
+
+	id, err := s.authenticate(ctx)
+	if err != nil {
+		return identity{}, err
+	}
+
+	if policy == authAdmin && !id.admin {
+		return identity{}, status.Error(codes.PermissionDenied, "admin token required")
+	}
+
+	return id, nil
+}
+
+func (s *Server) unaryAuthInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if methodPolicy(info.FullMethod) == authNone {
+		return handler(ctx, req)
+	}
+
+	id, err := s.authorizeCall(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizePayload(id, req); err != nil {
+		return nil, err
+	}
+
+	return handler(context.WithValue(ctx, identityKey{}, id), req)
+}
+
+func (s *Server) streamAuthInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if methodPolicy(info.FullMethod) == authNone {
+		return handler(srv, ss)
+	}
+
+	id, err := s.authorizeCall(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &authenticatedStream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), identityKey{}, id),
+		id:           id,
+	})
+}
+
+// authenticatedStream checks every received message against the caller, so
+// a device cannot switch to another UID partway through a stream.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	id  identity
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authenticatedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorizePayload(s.id, m)
+}
+
+// authorizePayload checks that a device only sends requests about itself.
+// Messages that carry no device UID, such as upload chunks or lookups by log
+// ID, pass here; handlers check ownership of what they look up.
+func authorizePayload(id identity, msg interface{}) error {
+	if id.admin {
+		return nil
+	}
+
+	uid, ok := payloadUID(msg)
+	if !ok {
+		return nil
+	}
+	if uid != id.deviceUID {
+		return status.Errorf(codes.PermissionDenied, "token is not valid for device %q", uid)
+	}
+	return nil
+}
+
+// payloadUID returns the device UID a request refers to: its own uid field,
+// or that of the message set in one of its oneofs, as in the stream
+// envelopes.
+func payloadUID(msg interface{}) (string, bool) {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return "", false
+	}
+
+	r := m.ProtoReflect()
+	if uid, ok := uidField(r); ok {
+		return uid, true
+	}
+
+	oneofs := r.Descriptor().Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		fd := r.WhichOneof(oneofs.Get(i))
+		if fd != nil && fd.Kind() == protoreflect.MessageKind {
+			if uid, ok := uidField(r.Get(fd).Message()); ok {
+				return uid, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func uidField(m protoreflect.Message) (string, bool) {
+	fd := m.Descriptor().Fields().ByName("uid")
+	if fd == nil || fd.Kind() != protoreflect.StringKind {
+		return "", false
+	}
+	return m.Get(fd).String(), true
+}
+
+// authorizeOwner is used by handlers that look data up by something other
+// than the device UID, to check the caller may see data of deviceUID.
+func authorizeOwner(ctx context.Context, deviceUID string) error {
+	id, ok := identityFromContext(ctx)
+	if ok && (id.admin || id.deviceUID == deviceUID) {
+		return nil
+	}
+	return status.Error(codes.PermissionDenied, "token is not valid for this device")
+}
@@ -0,0 +1,41 @@
+package grpc
+
+import (
+	"github.com/vtapaskar/brahma/internal/health"
+	brahmav1 "github.com/vtapaskar/brahma/proto/brahma/v1"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Probe service names for the standard health service. Liveness is serving
+// for as long as the process is, whatever its dependencies do; readiness, like
+// the overall "" status, requires every dependency check to pass.
+const (
+	livenessService  = "liveness"
+	readinessService = "readiness"
+)
+
+var allChecks = []string{health.CheckSplunk, health.CheckS3, health.CheckQueue}
+
+// serviceChecks lists the dependency checks each service needs to pass to be
+// reported as serving. The device registry is local, so DeviceService only
+// waits for the first round of checks.
+var serviceChecks = map[string][]string{
+	"":               allChecks,
+	readinessService: allChecks,
+	brahmav1.DeviceService_ServiceDesc.ServiceName:  nil,
+	brahmav1.MetricsService_ServiceDesc.ServiceName: {health.CheckSplunk, health.CheckQueue},
+	brahmav1.LogService_ServiceDesc.ServiceName:     {health.CheckS3},
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+func (s *Server) updateHealth() {
+	for service, checks := range serviceChecks {
+		s.health.SetServingStatus(service, servingStatus(s.checker.Healthy(checks...)))
+	}
+}
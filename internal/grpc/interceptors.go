@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/requestid"
+	"github.com/vtapaskar/brahma/internal/telemetry"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// The interceptors below are chained in NewServer, outermost first: request
+// ID, access log, Prometheus metrics, panic recovery, then authentication.
+
+// contextStream replaces the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// withRequestID takes the caller's x-request-id, or generates one, stores it
+// in the context and returns it in the response headers.
+func withRequestID(ctx context.Context) context.Context {
+	var id string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestid.Header); len(values) > 0 {
+			id = values[0]
+		}
+	}
+	id = requestid.Sanitize(id)
+
+	grpc.SetHeader(ctx, metadata.Pairs(requestid.Header, id))
+	return requestid.NewContext(ctx, id)
+}
+
+func unaryRequestIDInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(withRequestID(ctx), req)
+}
+
+func streamRequestIDInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &contextStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+}
+
+// Health probes arrive every few seconds and would drown out the access log.
+func isHealthProbe(method string) bool {
+	return strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+func unaryLoggingInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if isHealthProbe(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		uid, _ := payloadUID(req)
+		logRPC(ctx, logger, info.FullMethod, uid, start, err)
+		return resp, err
+	}
+}
+
+func streamLoggingInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isHealthProbe(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		start := time.Now()
+		stream := &loggedStream{ServerStream: ss}
+		err := handler(srv, stream)
+
+		logRPC(ss.Context(), logger, info.FullMethod, stream.uid, start, err)
+		return err
+	}
+}
+
+// loggedStream remembers the first device UID received on a stream for the
+// access log.
+type loggedStream struct {
+	grpc.ServerStream
+	uid string
+}
+
+func (s *loggedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.uid == "" {
+		s.uid, _ = payloadUID(m)
+	}
+	return err
+}
+
+func logRPC(ctx context.Context, logger *zap.Logger, method, uid string, start time.Time, err error) {
+	code := status.Code(err)
+
+	fields := []zap.Field{
+		zap.String("method", method),
+		zap.String("code", code.String()),
+		zap.Duration("duration", time.Since(start)),
+		zap.String("request_id", requestid.FromContext(ctx)),
+	}
+	if uid != "" {
+		fields = append(fields, zap.String("uid", uid))
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, zap.String("peer", p.Addr.String()))
+	}
+	if err != nil {
+		fields = append(fields, zap.String("error", status.Convert(err).Message()))
+	}
+
+	level := zapcore.InfoLevel
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unimplemented:
+		level = zapcore.ErrorLevel
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded:
+		level = zapcore.WarnLevel
+	}
+
+	logger.Log(level, "gRPC request", fields...)
+}
+
+func observeRPC(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+	telemetry.RPCRequests.WithLabelValues(method, code).Inc()
+	telemetry.RPCDuration.WithLabelValues(method, code).Observe(telemetry.Since(start))
+}
+
+func unaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeRPC(info.FullMethod, start, err)
+	return resp, err
+}
+
+func streamMetricsInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	observeRPC(info.FullMethod, start, err)
+	return err
+}
+
+// recoverPanic turns a panic in a handler into an Internal error for that
+// call instead of letting it take the process down.
+func recoverPanic(ctx context.Context, logger *zap.Logger, method string, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	logger.Error("Recovered from panic in gRPC handler",
+		zap.String("method", method),
+		zap.String("request_id", requestid.FromContext(ctx)),
+		zap.Any("panic", r),
+		zap.ByteString("stack", debug.Stack()),
+	)
+	*err = status.Error(codes.Internal, "internal error")
+}
+
+func unaryRecoveryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer recoverPanic(ctx, logger, info.FullMethod, &err)
+		return handler(ctx, req)
+	}
+}
+
+func streamRecoveryInterceptor(logger *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer recoverPanic(ss.Context(), logger, info.FullMethod, &err)
+		return handler(srv, ss)
+	}
+}
@@ -2,47 +2,98 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"sort"
 
 	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/health"
 	"github.com/vtapaskar/brahma/internal/metrics"
+	"github.com/vtapaskar/brahma/internal/models"
 	"github.com/vtapaskar/brahma/internal/registry"
+	"github.com/vtapaskar/brahma/internal/storage"
+	brahmav1 "github.com/vtapaskar/brahma/proto/brahma/v1"
 	"go.uber.org/zap"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+const downloadChunkSize = 64 * 1024
+
 type Server struct {
 	config    config.GRPCConfig
 	collector *metrics.Collector
 	registry  *registry.Registry
+	checker   *health.Checker
+	health    *grpchealth.Server
 	logger    *zap.Logger
 	server    *grpc.Server
-	UnimplementedDeviceServiceServer
-	UnimplementedMetricsServiceServer
-	UnimplementedLogServiceServer
+	mtls      bool
+	shutdown  chan struct{}
+	brahmav1.UnimplementedDeviceServiceServer
+	brahmav1.UnimplementedMetricsServiceServer
+	brahmav1.UnimplementedLogServiceServer
+	brahmav1.UnimplementedAdminServiceServer
 }
 
-func NewServer(cfg config.GRPCConfig, collector *metrics.Collector, reg *registry.Registry, logger *zap.Logger) *Server {
+func NewServer(cfg config.GRPCConfig, collector *metrics.Collector, reg *registry.Registry, checker *health.Checker, logger *zap.Logger) (*Server, error) {
 	s := &Server{
 		config:    cfg,
 		collector: collector,
 		registry:  reg,
+		checker:   checker,
+		health:    grpchealth.NewServer(),
 		logger:    logger,
+		shutdown:  make(chan struct{}),
+	}
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(
+			unaryRequestIDInterceptor,
+			unaryLoggingInterceptor(logger),
+			unaryMetricsInterceptor,
+			unaryRecoveryInterceptor(logger),
+			s.unaryAuthInterceptor,
+		),
+		grpc.ChainStreamInterceptor(
+			streamRequestIDInterceptor,
+			streamLoggingInterceptor(logger),
+			streamMetricsInterceptor,
+			streamRecoveryInterceptor(logger),
+			s.streamAuthInterceptor,
+		),
+	}
+
+	if cfg.EnableTLS {
+		creds, err := loadTLSCredentials(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(creds))
+		s.mtls = cfg.ClientCAFile != ""
 	}
 
-	opts := []grpc.ServerOption{}
 	s.server = grpc.NewServer(opts...)
 
-	RegisterDeviceServiceServer(s.server, s)
-	RegisterMetricsServiceServer(s.server, s)
-	RegisterLogServiceServer(s.server, s)
+	brahmav1.RegisterDeviceServiceServer(s.server, s)
+	brahmav1.RegisterMetricsServiceServer(s.server, s)
+	brahmav1.RegisterLogServiceServer(s.server, s)
+	brahmav1.RegisterAdminServiceServer(s.server, s)
+	healthpb.RegisterHealthServer(s.server, s.health)
 
-	return s
+	s.health.SetServingStatus(livenessService, healthpb.HealthCheckResponse_SERVING)
+	s.updateHealth()
+	checker.OnUpdate(s.updateHealth)
+
+	return s, nil
 }
 
 func (s *Server) Start() error {
@@ -52,24 +103,67 @@ func (s *Server) Start() error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
-	s.logger.Info("gRPC server starting", zap.String("address", addr))
+	s.logger.Info("gRPC server starting",
+		zap.String("address", addr),
+		zap.Bool("tls", s.config.EnableTLS),
+		zap.Bool("mtls", s.mtls),
+	)
 	return s.server.Serve(lis)
 }
 
 func (s *Server) Stop() {
+	// Watch streams never finish on their own; end them so GracefulStop
+	// only waits for in-flight requests.
+	close(s.shutdown)
+	s.health.Shutdown()
 	s.server.GracefulStop()
 }
 
-func (s *Server) validateUID(uid string) bool {
-	_, exists := s.registry.GetByUID(uid)
-	return exists
+// authorizeDevice checks that uid is registered and, with mutual TLS, that
+// the caller's certificate belongs to that device.
+func (s *Server) authorizeDevice(ctx context.Context, uid string) error {
+	device, exists := s.registry.GetByUID(uid)
+	if !exists {
+		return status.Error(codes.NotFound, "device not registered")
+	}
+	return s.authorizeForeignKey(ctx, device.ForeignKey)
+}
+
+// collectError maps a Collector error to a gRPC status. A full ingestion
+// queue becomes ResourceExhausted with a RetryInfo hint so agents back off
+// instead of retrying immediately.
+func (s *Server) collectError(err error, what string) error {
+	if !errors.Is(err, metrics.ErrQueueFull) {
+		return status.Errorf(codes.Internal, "failed to collect %s: %v", what, err)
+	}
+
+	st := status.New(codes.ResourceExhausted, "metrics queue is full, retry later")
+	detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(s.collector.RetryDelay()),
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
 
-func (s *Server) Register(ctx context.Context, req *RegisterRequest) (*RegisterResponse, error) {
+func (s *Server) Register(ctx context.Context, req *brahmav1.RegisterRequest) (*brahmav1.RegisterResponse, error) {
 	if req.ForeignKey == "" {
 		return nil, status.Error(codes.InvalidArgument, "foreign_key is required")
 	}
 
+	if err := s.authorizeForeignKey(ctx, req.ForeignKey); err != nil {
+		return nil, err
+	}
+
+	// Registering again replaces the device's token, so only the device
+	// itself may do it. A client certificate already proves that.
+	if token := bearerToken(ctx); !s.mtls && !s.isAdminToken(token) {
+		if err := s.registry.CheckReregistration(req.ForeignKey, token); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "device is already registered: %v", err)
+		}
+	}
+
 	regReq := registry.RegistrationRequest{
 		ForeignKey: req.ForeignKey,
 		Hostname:   req.Hostname,
@@ -80,91 +174,216 @@ func (s *Server) Register(ctx context.Context, req *RegisterRequest) (*RegisterR
 		Labels:     req.Labels,
 	}
 
-	device, err := s.registry.Register(regReq)
+	device, token, err := s.registry.Register(ctx, regReq)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to register device: %v", err)
 	}
 
-	return &RegisterResponse{
+	return &brahmav1.RegisterResponse{
 		Uid:          device.UID,
 		ForeignKey:   device.ForeignKey,
 		Status:       "registered",
 		RegisteredAt: timestamppb.New(device.RegisteredAt),
+		Token:        token,
 	}, nil
 }
 
-func (s *Server) Unregister(ctx context.Context, req *UnregisterRequest) (*UnregisterResponse, error) {
-	if !s.registry.Unregister(req.Uid) {
+func (s *Server) Unregister(ctx context.Context, req *brahmav1.UnregisterRequest) (*brahmav1.UnregisterResponse, error) {
+	if !s.registry.Unregister(ctx, req.Uid) {
 		return nil, status.Error(codes.NotFound, "device not found")
 	}
 
-	return &UnregisterResponse{
+	return &brahmav1.UnregisterResponse{
 		Status: "unregistered",
 		Uid:    req.Uid,
 	}, nil
 }
 
-func (s *Server) GetDevice(ctx context.Context, req *GetDeviceRequest) (*DeviceResponse, error) {
+func (s *Server) GetDevice(ctx context.Context, req *brahmav1.GetDeviceRequest) (*brahmav1.DeviceResponse, error) {
 	device, exists := s.registry.GetByUID(req.Uid)
 	if !exists {
 		return nil, status.Error(codes.NotFound, "device not found")
 	}
 
-	return &DeviceResponse{
-		Uid:          device.UID,
-		ForeignKey:   device.ForeignKey,
-		Hostname:     device.Hostname,
-		IpAddress:    device.IPAddress,
-		DeviceType:   device.DeviceType,
-		Platform:     device.Platform,
-		Version:      device.Version,
-		Labels:       device.Labels,
-		RegisteredAt: timestamppb.New(device.RegisteredAt),
-		LastSeen:     timestamppb.New(device.LastSeen),
-	}, nil
+	return deviceResponse(device), nil
 }
 
-func (s *Server) ListDevices(ctx context.Context, req *ListDevicesRequest) (*ListDevicesResponse, error) {
-	devices := s.registry.ListDevices()
+func (s *Server) ListDevices(ctx context.Context, req *brahmav1.ListDevicesRequest) (*brahmav1.ListDevicesResponse, error) {
+	selector, err := registry.ParseSelector(req.LabelSelector)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-	resp := &ListDevicesResponse{
-		Total: int32(len(devices)),
+	var state registry.DeviceState
+	if req.State != "" {
+		if state, err = registry.ParseDeviceState(req.State); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	devices, nextPageToken, total, err := s.registry.Query(registry.DeviceQuery{
+		Selector:   selector,
+		DeviceType: req.DeviceType,
+		Platform:   req.Platform,
+		Version:    req.Version,
+		State:      state,
+		Limit:      int(req.Limit),
+		Offset:     int(req.Offset), //nolint:staticcheck // still honoured for older clients
+		PageToken:  req.PageToken,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &brahmav1.ListDevicesResponse{
+		Total:         int32(total),
+		NextPageToken: nextPageToken,
 	}
 
 	for _, d := range devices {
-		resp.Devices = append(resp.Devices, &DeviceResponse{
-			Uid:          d.UID,
-			ForeignKey:   d.ForeignKey,
-			Hostname:     d.Hostname,
-			IpAddress:    d.IPAddress,
-			DeviceType:   d.DeviceType,
-			Platform:     d.Platform,
-			Version:      d.Version,
-			Labels:       d.Labels,
-			RegisteredAt: timestamppb.New(d.RegisteredAt),
-			LastSeen:     timestamppb.New(d.LastSeen),
-		})
+		resp.Devices = append(resp.Devices, deviceResponse(d))
 	}
 
 	return resp, nil
 }
 
-func (s *Server) Heartbeat(ctx context.Context, req *HeartbeatRequest) (*HeartbeatResponse, error) {
-	if !s.validateUID(req.Uid) {
-		return nil, status.Error(codes.NotFound, "device not registered")
+func deviceResponse(d *registry.DeviceRegistration) *brahmav1.DeviceResponse {
+	return &brahmav1.DeviceResponse{
+		Uid:          d.UID,
+		ForeignKey:   d.ForeignKey,
+		Hostname:     d.Hostname,
+		IpAddress:    d.IPAddress,
+		DeviceType:   d.DeviceType,
+		Platform:     d.Platform,
+		Version:      d.Version,
+		Labels:       d.Labels,
+		RegisteredAt: timestamppb.New(d.RegisteredAt),
+		LastSeen:     timestamppb.New(d.LastSeen),
+		State:        string(d.State),
+	}
+}
+
+func (s *Server) WatchDevices(req *brahmav1.WatchDevicesRequest, stream brahmav1.DeviceService_WatchDevicesServer) error {
+	selector, err := registry.ParseSelector(req.LabelSelector)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	watcher, err := s.registry.Watch(req.ResumeRevision)
+	if errors.Is(err, registry.ErrRevisionCompacted) {
+		return status.Errorf(codes.OutOfRange, "revision %d is no longer available, watch again without resume_revision", req.ResumeRevision)
+	}
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer watcher.Close()
+
+	sort.Slice(watcher.Snapshot, func(i, j int) bool { return watcher.Snapshot[i].UID < watcher.Snapshot[j].UID })
+	for i := range watcher.Snapshot {
+		device := &watcher.Snapshot[i]
+		if !selector.Matches(device.Labels) {
+			continue
+		}
+		if err := stream.Send(&brahmav1.DeviceEvent{
+			Type:     brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_ADDED,
+			Revision: watcher.Revision,
+			Device:   deviceResponse(device),
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, change := range watcher.Backlog {
+		if event, ok := deviceEvent(change, selector); ok {
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := stream.Send(&brahmav1.DeviceEvent{
+		Type:     brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_SYNCED,
+		Revision: watcher.Revision,
+	}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case change, ok := <-watcher.Events():
+			if !ok {
+				if err := watcher.Err(); errors.Is(err, registry.ErrWatcherLagged) {
+					return status.Errorf(codes.Aborted, "%v, resume from the last revision received", err)
+				}
+				return status.Error(codes.Unavailable, "device registry is shutting down")
+			}
+			if event, ok := deviceEvent(change, selector); ok {
+				if err := stream.Send(event); err != nil {
+					return err
+				}
+			}
+		case <-s.shutdown:
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// deviceEvent maps a registry change onto a watch filtered by selector. A
+// modification that moves a device into or out of the selection is reported
+// as ADDED or DELETED so the client's view stays consistent.
+func deviceEvent(change registry.Change, selector registry.Selector) (*brahmav1.DeviceEvent, bool) {
+	event := func(eventType brahmav1.DeviceEventType) (*brahmav1.DeviceEvent, bool) {
+		return &brahmav1.DeviceEvent{
+			Type:     eventType,
+			Revision: change.Revision,
+			Device:   deviceResponse(&change.Device),
+		}, true
+	}
+
+	matches := selector.Matches(change.Device.Labels)
+
+	switch change.Type {
+	case registry.ChangeAdded:
+		if matches {
+			return event(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_ADDED)
+		}
+	case registry.ChangeDeleted:
+		if matches {
+			return event(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_DELETED)
+		}
+	case registry.ChangeModified:
+		matched := change.Previous != nil && selector.Matches(change.Previous.Labels)
+		switch {
+		case matches && matched:
+			return event(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_MODIFIED)
+		case matches:
+			return event(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_ADDED)
+		case matched:
+			return event(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_DELETED)
+		}
+	}
+
+	return nil, false
+}
+
+func (s *Server) Heartbeat(ctx context.Context, req *brahmav1.HeartbeatRequest) (*brahmav1.HeartbeatResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
 	}
 
-	s.registry.UpdateLastSeen(req.Uid)
+	s.registry.UpdateLastSeen(ctx, req.Uid)
 
-	return &HeartbeatResponse{
+	return &brahmav1.HeartbeatResponse{
 		Status:     "ok",
 		ServerTime: timestamppb.Now(),
 	}, nil
 }
 
-func (s *Server) ReportCPUStats(ctx context.Context, req *CPUStatsRequest) (*MetricsResponse, error) {
-	if !s.validateUID(req.Uid) {
-		return nil, status.Error(codes.NotFound, "device not registered")
+func (s *Server) ReportCPUStats(ctx context.Context, req *brahmav1.CPUStatsRequest) (*brahmav1.MetricsResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
 	}
 
 	stats := &metrics.CPUStats{
@@ -181,21 +400,21 @@ func (s *Server) ReportCPUStats(ctx context.Context, req *CPUStatsRequest) (*Met
 		PerCoreUsage:  req.PerCoreUsage,
 	}
 
-	if err := s.collector.CollectCPUStats(stats); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to collect CPU stats: %v", err)
+	if err := s.collector.CollectCPUStats(ctx, stats); err != nil {
+		return nil, s.collectError(err, "CPU stats")
 	}
 
-	s.registry.UpdateLastSeen(req.Uid)
+	s.registry.UpdateLastSeen(ctx, req.Uid)
 
-	return &MetricsResponse{
+	return &brahmav1.MetricsResponse{
 		Status: "accepted",
 		Uid:    req.Uid,
 	}, nil
 }
 
-func (s *Server) ReportProcessStats(ctx context.Context, req *ProcessStatsRequest) (*MetricsResponse, error) {
-	if !s.validateUID(req.Uid) {
-		return nil, status.Error(codes.NotFound, "device not registered")
+func (s *Server) ReportProcessStats(ctx context.Context, req *brahmav1.ProcessStatsRequest) (*brahmav1.MetricsResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
 	}
 
 	stats := &metrics.ProcessStats{
@@ -220,21 +439,21 @@ func (s *Server) ReportProcessStats(ctx context.Context, req *ProcessStatsReques
 		})
 	}
 
-	if err := s.collector.CollectProcessStats(stats); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to collect process stats: %v", err)
+	if err := s.collector.CollectProcessStats(ctx, stats); err != nil {
+		return nil, s.collectError(err, "process stats")
 	}
 
-	s.registry.UpdateLastSeen(req.Uid)
+	s.registry.UpdateLastSeen(ctx, req.Uid)
 
-	return &MetricsResponse{
+	return &brahmav1.MetricsResponse{
 		Status: "accepted",
 		Uid:    req.Uid,
 	}, nil
 }
 
-func (s *Server) ReportMgmtNetworkStats(ctx context.Context, req *MgmtNetworkStatsRequest) (*MetricsResponse, error) {
-	if !s.validateUID(req.Uid) {
-		return nil, status.Error(codes.NotFound, "device not registered")
+func (s *Server) ReportMgmtNetworkStats(ctx context.Context, req *brahmav1.MgmtNetworkStatsRequest) (*brahmav1.MetricsResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
 	}
 
 	stats := &metrics.MgmtNetworkStats{
@@ -259,21 +478,21 @@ func (s *Server) ReportMgmtNetworkStats(ctx context.Context, req *MgmtNetworkSta
 		NTPServers:    req.NtpServers,
 	}
 
-	if err := s.collector.CollectMgmtNetworkStats(stats); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to collect mgmt network stats: %v", err)
+	if err := s.collector.CollectMgmtNetworkStats(ctx, stats); err != nil {
+		return nil, s.collectError(err, "mgmt network stats")
 	}
 
-	s.registry.UpdateLastSeen(req.Uid)
+	s.registry.UpdateLastSeen(ctx, req.Uid)
 
-	return &MetricsResponse{
+	return &brahmav1.MetricsResponse{
 		Status: "accepted",
 		Uid:    req.Uid,
 	}, nil
 }
 
-func (s *Server) ReportRouterBaseState(ctx context.Context, req *RouterBaseStateRequest) (*MetricsResponse, error) {
-	if !s.validateUID(req.Uid) {
-		return nil, status.Error(codes.NotFound, "device not registered")
+func (s *Server) ReportRouterBaseState(ctx context.Context, req *brahmav1.RouterBaseStateRequest) (*brahmav1.MetricsResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
 	}
 
 	state := &metrics.RouterBaseState{
@@ -346,26 +565,163 @@ func (s *Server) ReportRouterBaseState(ctx context.Context, req *RouterBaseState
 		}
 	}
 
-	if err := s.collector.CollectRouterBaseState(state); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to collect router base state: %v", err)
+	if err := s.collector.CollectRouterBaseState(ctx, state); err != nil {
+		return nil, s.collectError(err, "router base state")
 	}
 
-	s.registry.UpdateLastSeen(req.Uid)
+	s.registry.UpdateLastSeen(ctx, req.Uid)
 
-	return &MetricsResponse{
+	return &brahmav1.MetricsResponse{
 		Status: "accepted",
 		Uid:    req.Uid,
 	}, nil
 }
 
-func (s *Server) StreamMetrics(stream MetricsService_StreamMetricsServer) error {
+func (s *Server) ReportInterfaceStats(ctx context.Context, req *brahmav1.InterfaceStatsRequest) (*brahmav1.MetricsResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
+	}
+
+	stats := &metrics.InterfaceStats{
+		UID: req.Uid,
+	}
+
+	for _, i := range req.Interfaces {
+		stats.Interfaces = append(stats.Interfaces, models.InterfaceMetric{
+			Name:        i.Name,
+			Status:      i.Status,
+			Speed:       i.Speed,
+			MTU:         int(i.Mtu),
+			RxBytes:     i.RxBytes,
+			TxBytes:     i.TxBytes,
+			RxPackets:   i.RxPackets,
+			TxPackets:   i.TxPackets,
+			RxErrors:    i.RxErrors,
+			TxErrors:    i.TxErrors,
+			RxDropped:   i.RxDropped,
+			TxDropped:   i.TxDropped,
+			Utilization: i.Utilization,
+		})
+	}
+
+	if err := s.collector.CollectInterfaceStats(ctx, stats); err != nil {
+		return nil, s.collectError(err, "interface stats")
+	}
+
+	s.registry.UpdateLastSeen(ctx, req.Uid)
+
+	return &brahmav1.MetricsResponse{
+		Status: "accepted",
+		Uid:    req.Uid,
+	}, nil
+}
+
+func (s *Server) ReportBGPNeighbors(ctx context.Context, req *brahmav1.BGPNeighborsRequest) (*brahmav1.MetricsResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
+	}
+
+	stats := &metrics.BGPNeighborStats{
+		UID: req.Uid,
+	}
+
+	for _, n := range req.Neighbors {
+		stats.Neighbors = append(stats.Neighbors, models.BGPMetric{
+			NeighborIP:     n.NeighborIp,
+			NeighborAS:     int(n.NeighborAs),
+			State:          n.State,
+			PrefixReceived: int(n.PrefixReceived),
+			PrefixSent:     int(n.PrefixSent),
+			Uptime:         n.UptimeSeconds,
+			MessagesIn:     n.MessagesIn,
+			MessagesOut:    n.MessagesOut,
+		})
+	}
+
+	if err := s.collector.CollectBGPNeighborStats(ctx, stats); err != nil {
+		return nil, s.collectError(err, "BGP neighbors")
+	}
+
+	s.registry.UpdateLastSeen(ctx, req.Uid)
+
+	return &brahmav1.MetricsResponse{
+		Status: "accepted",
+		Uid:    req.Uid,
+	}, nil
+}
+
+func (s *Server) ReportVXLANTunnels(ctx context.Context, req *brahmav1.VXLANTunnelsRequest) (*brahmav1.MetricsResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
+	}
+
+	stats := &metrics.VXLANTunnelStats{
+		UID: req.Uid,
+	}
+
+	for _, t := range req.Tunnels {
+		stats.Tunnels = append(stats.Tunnels, models.VXLANMetric{
+			VNI:         int(t.Vni),
+			SourceIP:    t.SourceIp,
+			RemoteVTEPs: int(t.RemoteVteps),
+			MACCount:    int(t.MacCount),
+			ARPCount:    int(t.ArpCount),
+		})
+	}
+
+	if err := s.collector.CollectVXLANTunnelStats(ctx, stats); err != nil {
+		return nil, s.collectError(err, "VXLAN tunnels")
+	}
+
+	s.registry.UpdateLastSeen(ctx, req.Uid)
+
+	return &brahmav1.MetricsResponse{
+		Status: "accepted",
+		Uid:    req.Uid,
+	}, nil
+}
+
+func (s *Server) ReportQoSQueues(ctx context.Context, req *brahmav1.QoSQueuesRequest) (*brahmav1.MetricsResponse, error) {
+	if err := s.authorizeDevice(ctx, req.Uid); err != nil {
+		return nil, err
+	}
+
+	stats := &metrics.QoSQueueStats{
+		UID: req.Uid,
+	}
+
+	for _, q := range req.Queues {
+		stats.Queues = append(stats.Queues, models.QoSMetric{
+			Interface:      q.Interface,
+			Queue:          q.Queue,
+			Priority:       int(q.Priority),
+			PacketsQueued:  q.PacketsQueued,
+			PacketsDropped: q.PacketsDropped,
+			BytesQueued:    q.BytesQueued,
+		})
+	}
+
+	if err := s.collector.CollectQoSQueueStats(ctx, stats); err != nil {
+		return nil, s.collectError(err, "QoS queues")
+	}
+
+	s.registry.UpdateLastSeen(ctx, req.Uid)
+
+	return &brahmav1.MetricsResponse{
+		Status: "accepted",
+		Uid:    req.Uid,
+	}, nil
+}
+
+func (s *Server) StreamMetrics(stream brahmav1.MetricsService_StreamMetricsServer) error {
+	ctx := stream.Context()
 	var uid string
 	count := 0
 
 	for {
 		req, err := stream.Recv()
 		if err == io.EOF {
-			return stream.SendAndClose(&MetricsResponse{
+			return stream.SendAndClose(&brahmav1.MetricsResponse{
 				Status:  "accepted",
 				Uid:     uid,
 				Message: fmt.Sprintf("processed %d metrics", count),
@@ -375,77 +731,221 @@ func (s *Server) StreamMetrics(stream MetricsService_StreamMetricsServer) error
 			return err
 		}
 
+		// Each metric is handled like its unary call. A full queue ends the
+		// stream so the agent backs off; other failures only skip the
+		// metric. Only metrics that were enqueued are counted.
+		var what string
 		switch m := req.Metrics.(type) {
-		case *MetricsStreamRequest_CpuStats:
-			uid = m.CpuStats.Uid
-			if _, err := s.ReportCPUStats(stream.Context(), m.CpuStats); err != nil {
-				s.logger.Warn("Failed to process CPU stats in stream", zap.Error(err))
-			}
-		case *MetricsStreamRequest_ProcessStats:
-			uid = m.ProcessStats.Uid
-			if _, err := s.ReportProcessStats(stream.Context(), m.ProcessStats); err != nil {
-				s.logger.Warn("Failed to process process stats in stream", zap.Error(err))
-			}
-		case *MetricsStreamRequest_MgmtNetworkStats:
-			uid = m.MgmtNetworkStats.Uid
-			if _, err := s.ReportMgmtNetworkStats(stream.Context(), m.MgmtNetworkStats); err != nil {
-				s.logger.Warn("Failed to process mgmt network stats in stream", zap.Error(err))
-			}
-		case *MetricsStreamRequest_RouterBaseState:
-			uid = m.RouterBaseState.Uid
-			if _, err := s.ReportRouterBaseState(stream.Context(), m.RouterBaseState); err != nil {
-				s.logger.Warn("Failed to process router base state in stream", zap.Error(err))
+		case nil:
+			continue
+		case *brahmav1.MetricsStreamRequest_CpuStats:
+			uid, what = m.CpuStats.Uid, "CPU stats"
+			_, err = s.ReportCPUStats(ctx, m.CpuStats)
+		case *brahmav1.MetricsStreamRequest_ProcessStats:
+			uid, what = m.ProcessStats.Uid, "process stats"
+			_, err = s.ReportProcessStats(ctx, m.ProcessStats)
+		case *brahmav1.MetricsStreamRequest_MgmtNetworkStats:
+			uid, what = m.MgmtNetworkStats.Uid, "mgmt network stats"
+			_, err = s.ReportMgmtNetworkStats(ctx, m.MgmtNetworkStats)
+		case *brahmav1.MetricsStreamRequest_RouterBaseState:
+			uid, what = m.RouterBaseState.Uid, "router base state"
+			_, err = s.ReportRouterBaseState(ctx, m.RouterBaseState)
+		case *brahmav1.MetricsStreamRequest_InterfaceStats:
+			uid, what = m.InterfaceStats.Uid, "interface stats"
+			_, err = s.ReportInterfaceStats(ctx, m.InterfaceStats)
+		case *brahmav1.MetricsStreamRequest_BgpNeighbors:
+			uid, what = m.BgpNeighbors.Uid, "BGP neighbors"
+			_, err = s.ReportBGPNeighbors(ctx, m.BgpNeighbors)
+		case *brahmav1.MetricsStreamRequest_VxlanTunnels:
+			uid, what = m.VxlanTunnels.Uid, "VXLAN tunnels"
+			_, err = s.ReportVXLANTunnels(ctx, m.VxlanTunnels)
+		case *brahmav1.MetricsStreamRequest_QosQueues:
+			uid, what = m.QosQueues.Uid, "QoS queues"
+			_, err = s.ReportQoSQueues(ctx, m.QosQueues)
+		}
+		if err != nil {
+			if status.Code(err) == codes.ResourceExhausted {
+				return err
 			}
+			s.logger.Warn("Failed to process metric in stream", zap.String("metric", what), zap.Error(err))
+			continue
 		}
 		count++
 	}
 }
 
-func (s *Server) UploadCrashReport(stream LogService_UploadCrashReportServer) error {
-	var metadata *CrashReportMetadata
-	var content []byte
+func (s *Server) UploadCrashReport(stream brahmav1.LogService_UploadCrashReportServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
 
-	for {
+	data, ok := first.Data.(*brahmav1.CrashReportChunk_Metadata)
+	if !ok || data.Metadata == nil {
+		return status.Error(codes.InvalidArgument, "metadata must be the first message")
+	}
+	metadata := data.Metadata
+
+	if err := s.authorizeDevice(stream.Context(), metadata.Uid); err != nil {
+		return err
+	}
+
+	report := &metrics.LogReport{
+		DeviceUID:      metadata.Uid,
+		ProcessTag:     metadata.ProcessTag,
+		Version:        metadata.Version,
+		Filename:       metadata.Filename,
+		ExpectedSize:   metadata.Size,
+		ExpectedSHA256: metadata.Sha256,
+	}
+	if err := report.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	upload := s.collector.StartCrashReport(stream.Context(), report)
+
+	err = receiveLogContent(upload, func() ([]byte, error) {
 		chunk, err := stream.Recv()
-		if err == io.EOF {
-			break
-		}
 		if err != nil {
-			return err
+			return nil, err
+		}
+		data, ok := chunk.Data.(*brahmav1.CrashReportChunk_Chunk)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "metadata may only be sent once")
 		}
+		return data.Chunk, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logID, err := upload.Commit()
+	if err != nil {
+		return commitStatus(err, "crash report")
+	}
+
+	s.registry.UpdateLastSeen(stream.Context(), metadata.Uid)
 
-		switch data := chunk.Data.(type) {
-		case *CrashReportChunk_Metadata:
-			metadata = data.Metadata
-		case *CrashReportChunk_Chunk:
-			content = append(content, data.Chunk...)
+	return stream.SendAndClose(&brahmav1.LogUploadResponse{
+		Status: "created",
+		LogId:  logID,
+		Uid:    metadata.Uid,
+		S3Key:  report.S3Key,
+	})
+}
+
+func (s *Server) UploadBacktrace(stream brahmav1.LogService_UploadBacktraceServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	data, ok := first.Data.(*brahmav1.BacktraceChunk_Metadata)
+	if !ok || data.Metadata == nil {
+		return status.Error(codes.InvalidArgument, "metadata must be the first message")
+	}
+	metadata := data.Metadata
+
+	if err := s.authorizeDevice(stream.Context(), metadata.Uid); err != nil {
+		return err
+	}
+
+	report := &metrics.LogReport{
+		DeviceUID:      metadata.Uid,
+		ProcessTag:     metadata.ProcessTag,
+		Version:        metadata.Version,
+		Filename:       metadata.Filename,
+		ExpectedSize:   metadata.Size,
+		ExpectedSHA256: metadata.Sha256,
+	}
+	if err := report.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	upload := s.collector.StartBacktrace(stream.Context(), report)
+
+	err = receiveLogContent(upload, func() ([]byte, error) {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return nil, err
 		}
+		data, ok := chunk.Data.(*brahmav1.BacktraceChunk_Chunk)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "metadata may only be sent once")
+		}
+		return data.Chunk, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logID, err := upload.Commit()
+	if err != nil {
+		return commitStatus(err, "backtrace")
 	}
 
-	if metadata == nil {
-		return status.Error(codes.InvalidArgument, "metadata is required")
+	s.registry.UpdateLastSeen(stream.Context(), metadata.Uid)
+
+	return stream.SendAndClose(&brahmav1.LogUploadResponse{
+		Status: "created",
+		LogId:  logID,
+		Uid:    metadata.Uid,
+		S3Key:  report.S3Key,
+	})
+}
+
+func (s *Server) UploadTechSupport(stream brahmav1.LogService_UploadTechSupportServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		return err
 	}
 
-	if !s.validateUID(metadata.Uid) {
-		return status.Error(codes.NotFound, "device not registered")
+	data, ok := first.Data.(*brahmav1.TechSupportChunk_Metadata)
+	if !ok || data.Metadata == nil {
+		return status.Error(codes.InvalidArgument, "metadata must be the first message")
+	}
+	metadata := data.Metadata
+
+	if err := s.authorizeDevice(stream.Context(), metadata.Uid); err != nil {
+		return err
 	}
 
 	report := &metrics.LogReport{
-		DeviceUID:  metadata.Uid,
-		ProcessTag: metadata.ProcessTag,
-		Version:    metadata.Version,
-		Filename:   metadata.Filename,
-		Content:    content,
+		DeviceUID:      metadata.Uid,
+		Version:        metadata.Version,
+		Filename:       metadata.Filename,
+		ExpectedSize:   metadata.Size,
+		ExpectedSHA256: metadata.Sha256,
+	}
+	if err := report.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	logID, err := s.collector.CollectCrashReport(report)
+	upload := s.collector.StartTechSupport(stream.Context(), report)
+
+	err = receiveLogContent(upload, func() ([]byte, error) {
+		chunk, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		data, ok := chunk.Data.(*brahmav1.TechSupportChunk_Chunk)
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "metadata may only be sent once")
+		}
+		return data.Chunk, nil
+	})
 	if err != nil {
-		return status.Errorf(codes.Internal, "failed to store crash report: %v", err)
+		return err
 	}
 
-	s.registry.UpdateLastSeen(metadata.Uid)
+	logID, err := upload.Commit()
+	if err != nil {
+		return commitStatus(err, "techsupport archive")
+	}
+
+	s.registry.UpdateLastSeen(stream.Context(), metadata.Uid)
 
-	return stream.SendAndClose(&LogUploadResponse{
+	return stream.SendAndClose(&brahmav1.LogUploadResponse{
 		Status: "created",
 		LogId:  logID,
 		Uid:    metadata.Uid,
@@ -453,62 +953,200 @@ func (s *Server) UploadCrashReport(stream LogService_UploadCrashReportServer) er
 	})
 }
 
-func (s *Server) UploadBacktrace(stream LogService_UploadBacktraceServer) error {
-	var metadata *BacktraceMetadata
-	var content []byte
+// commitStatus maps a failed LogUpload.Commit to a gRPC status.
+func commitStatus(err error, what string) error {
+	if errors.Is(err, metrics.ErrIntegrity) {
+		return status.Error(codes.DataLoss, err.Error())
+	}
+	if errors.Is(err, storage.ErrObjectTooLarge) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to store %s: %v", what, err)
+}
 
+// receiveLogContent copies chunks from next into upload until the client
+// closes its side of the stream. On any failure the upload is aborted so no
+// partial object or dangling multipart upload is left behind in S3.
+func receiveLogContent(upload *metrics.LogUpload, next func() ([]byte, error)) error {
 	for {
-		chunk, err := stream.Recv()
+		chunk, err := next()
 		if err == io.EOF {
-			break
+			return nil
 		}
 		if err != nil {
+			upload.Abort()
 			return err
 		}
 
-		switch data := chunk.Data.(type) {
-		case *BacktraceChunk_Metadata:
-			metadata = data.Metadata
-		case *BacktraceChunk_Chunk:
-			content = append(content, data.Chunk...)
+		if _, err := upload.Write(chunk); err != nil {
+			upload.Abort()
+			if errors.Is(err, storage.ErrObjectTooLarge) {
+				return status.Error(codes.InvalidArgument, err.Error())
+			}
+			return status.Errorf(codes.Unavailable, "failed to store upload: %v", err)
 		}
 	}
+}
 
-	if metadata == nil {
-		return status.Error(codes.InvalidArgument, "metadata is required")
+func (s *Server) GetLogMetadata(ctx context.Context, req *brahmav1.GetLogMetadataRequest) (*brahmav1.LogMetadataResponse, error) {
+	if req.LogId == "" {
+		return nil, status.Error(codes.InvalidArgument, "log_id is required")
 	}
 
-	if !s.validateUID(metadata.Uid) {
-		return status.Error(codes.NotFound, "device not registered")
+	metadata, exists := s.collector.GetLogMetadata(req.LogId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "log not found")
+	}
+	if err := authorizeOwner(ctx, metadata.DeviceUID); err != nil {
+		return nil, err
 	}
 
-	report := &metrics.LogReport{
-		DeviceUID:  metadata.Uid,
-		ProcessTag: metadata.ProcessTag,
-		Version:    metadata.Version,
-		Filename:   metadata.Filename,
-		Content:    content,
+	return logMetadataResponse(metadata), nil
+}
+
+func (s *Server) ListLogs(ctx context.Context, req *brahmav1.ListLogsRequest) (*brahmav1.ListLogsResponse, error) {
+	if req.Limit < 0 || req.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "limit and offset must not be negative")
 	}
 
-	logID, err := s.collector.CollectBacktrace(report)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to store backtrace: %v", err)
+	logs, total := s.collector.ListLogs(metrics.LogQuery{
+		DeviceUID: req.Uid,
+		LogType:   req.LogType,
+		Limit:     int(req.Limit),
+		Offset:    int(req.Offset),
+	})
+
+	resp := &brahmav1.ListLogsResponse{
+		Total: int32(total),
 	}
 
-	s.registry.UpdateLastSeen(metadata.Uid)
+	for _, l := range logs {
+		resp.Logs = append(resp.Logs, logMetadataResponse(l))
+	}
 
-	return stream.SendAndClose(&LogUploadResponse{
-		Status: "created",
-		LogId:  logID,
-		Uid:    metadata.Uid,
-		S3Key:  report.S3Key,
-	})
+	return resp, nil
+}
+
+func (s *Server) DownloadLog(req *brahmav1.DownloadLogRequest, stream brahmav1.LogService_DownloadLogServer) error {
+	if req.LogId == "" {
+		return status.Error(codes.InvalidArgument, "log_id is required")
+	}
+
+	metadata, exists := s.collector.GetLogMetadata(req.LogId)
+	if !exists {
+		return status.Error(codes.NotFound, "log not found")
+	}
+	if err := authorizeOwner(stream.Context(), metadata.DeviceUID); err != nil {
+		return err
+	}
+	if req.Symbolized && metadata.SymbolizedKey == "" {
+		return status.Error(codes.NotFound, "log has no symbolized copy")
+	}
+
+	var body io.ReadCloser
+	var err error
+	if req.Member != "" {
+		body, err = s.collector.OpenLogMember(stream.Context(), metadata, req.Member)
+	} else {
+		body, err = s.collector.OpenLog(stream.Context(), metadata, req.Symbolized)
+	}
+	switch {
+	case errors.Is(err, metrics.ErrNoManifest), errors.Is(err, metrics.ErrMemberNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, metrics.ErrIndexFailed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return status.Errorf(codes.Unavailable, "failed to open stored log: %v", err)
+	}
+	defer body.Close()
+
+	if err := stream.Send(&brahmav1.LogDownloadChunk{
+		Data: &brahmav1.LogDownloadChunk_Metadata{Metadata: logMetadataResponse(metadata)},
+	}); err != nil {
+		return err
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := io.ReadFull(body, buf)
+		if n > 0 {
+			chunk := &brahmav1.LogDownloadChunk{
+				Data: &brahmav1.LogDownloadChunk_Chunk{Chunk: buf[:n]},
+			}
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "failed to read stored log: %v", err)
+		}
+	}
 }
 
-func (s *Server) GetLogMetadata(ctx context.Context, req *GetLogMetadataRequest) (*LogMetadataResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+func (s *Server) GetTechSupportManifest(ctx context.Context, req *brahmav1.GetTechSupportManifestRequest) (*brahmav1.TechSupportManifest, error) {
+	if req.LogId == "" {
+		return nil, status.Error(codes.InvalidArgument, "log_id is required")
+	}
+
+	metadata, exists := s.collector.GetLogMetadata(req.LogId)
+	if !exists {
+		return nil, status.Error(codes.NotFound, "log not found")
+	}
+	if err := authorizeOwner(ctx, metadata.DeviceUID); err != nil {
+		return nil, err
+	}
+
+	manifest, err := s.collector.GetTechSupportManifest(ctx, metadata)
+	if errors.Is(err, metrics.ErrNoManifest) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, metrics.ErrIndexFailed) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to load techsupport manifest: %v", err)
+	}
+
+	resp := &brahmav1.TechSupportManifest{
+		LogId: metadata.LogID,
+		Error: manifest.Error,
+	}
+	for _, m := range manifest.Members {
+		resp.Members = append(resp.Members, &brahmav1.TechSupportMember{
+			Path:    m.Path,
+			Kind:    m.Kind,
+			Size:    m.Size,
+			ModTime: timestamppb.New(m.ModTime),
+		})
+	}
+
+	return resp, nil
 }
 
-func (s *Server) ListLogs(ctx context.Context, req *ListLogsRequest) (*ListLogsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+func logMetadataResponse(m *metrics.LogMetadata) *brahmav1.LogMetadataResponse {
+	return &brahmav1.LogMetadataResponse{
+		LogId:           m.LogID,
+		DeviceUid:       m.DeviceUID,
+		LogType:         m.LogType,
+		ProcessTag:      m.ProcessTag,
+		Version:         m.Version,
+		Filename:        m.Filename,
+		S3Key:           m.S3Key,
+		Timestamp:       timestamppb.New(m.Timestamp),
+		Signature:       m.Signature,
+		SymbolizedS3Key: m.SymbolizedKey,
+		Format:          m.Format,
+		Signal:          m.Signal,
+		Thread:          m.Thread,
+		Exception:       m.Exception,
+		Taint:           m.Taint,
+		TopFrame:        m.TopFrame,
+		Frames:          m.Frames,
+		MemberCount:     int32(m.MemberCount),
+		Size:            m.Size,
+		Sha256:          m.SHA256,
+	}
 }
@@ -0,0 +1,1577 @@
+package grpc
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"debug/elf"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/crash"
+	"github.com/vtapaskar/brahma/internal/health"
+	"github.com/vtapaskar/brahma/internal/metrics"
+	"github.com/vtapaskar/brahma/internal/registry"
+	"github.com/vtapaskar/brahma/internal/requestid"
+	"github.com/vtapaskar/brahma/internal/sink"
+	"github.com/vtapaskar/brahma/internal/splunk"
+	"github.com/vtapaskar/brahma/internal/storage"
+	"github.com/vtapaskar/brahma/internal/symbols"
+	"github.com/vtapaskar/brahma/internal/telemetry"
+	brahmav1 "github.com/vtapaskar/brahma/proto/brahma/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakeSplunk accepts HEC event batches and records the event bodies.
+type fakeSplunk struct {
+	mu     sync.Mutex
+	events []map[string]interface{}
+}
+
+func (f *fakeSplunk) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/services/collector/health" {
+		w.Write([]byte(`{"text":"HEC is healthy","code":17}`))
+		return
+	}
+
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var event splunk.Event
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			http.Error(w, `{"text":"Invalid data format","code":6}`, http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		f.events = append(f.events, event.Event)
+		f.mu.Unlock()
+	}
+	w.Write([]byte(`{"text":"Success","code":0}`))
+}
+
+func (f *fakeSplunk) find(key, value string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, event := range f.events {
+		if event[key] == value {
+			return event
+		}
+	}
+	return nil
+}
+
+func (f *fakeSplunk) eventTypes() map[string]int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	types := make(map[string]int)
+	for _, event := range f.events {
+		if eventType, ok := event["event_type"].(string); ok {
+			types[eventType]++
+		}
+	}
+	return types
+}
+
+// fakeS3 implements the path-style calls used for log uploads: PutObject, the
+// multipart upload calls, ranged GetObject, and HeadBucket. It checks the
+// SHA-256 checksum sent with each object and part, and records the checksum
+// of each object. With denied set, HeadBucket fails.
+type fakeS3 struct {
+	mu        sync.Mutex
+	objects   map[string][]byte
+	checksums map[string]string
+	sha256s   map[string]string // x-amz-meta-sha256
+	uploads   map[string]*fakeMultipartUpload
+	nextID    int
+	denied    bool
+	// hideArchives makes techsupport archives look missing when read back.
+	hideArchives bool
+}
+
+type fakeMultipartUpload struct {
+	key       string
+	sha256    string
+	parts     map[int][]byte
+	checksums map[int]string
+}
+
+// completeMultipartUpload is the body of CompleteMultipartUpload.
+type completeMultipartUpload struct {
+	Parts []struct {
+		PartNumber     int
+		ChecksumSHA256 string
+	} `xml:"Part"`
+}
+
+func (f *fakeS3) setDenied(denied bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.denied = denied
+}
+
+func (f *fakeS3) setHideArchives(hide bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.hideArchives = hide
+}
+
+func fakeChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/")
+	query := r.URL.Query()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Method == http.MethodHead && !strings.Contains(key, "/") {
+		if f.denied {
+			w.WriteHeader(http.StatusForbidden)
+		}
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if checksum := r.Header.Get("X-Amz-Checksum-Sha256"); checksum != "" && checksum != fakeChecksum(body) {
+		http.Error(w, "BadDigest", http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		if r.Header.Get("X-Amz-Checksum-Algorithm") != "SHA256" {
+			http.Error(w, "multipart upload without a checksum algorithm", http.StatusBadRequest)
+			return
+		}
+		f.nextID++
+		id := strconv.Itoa(f.nextID)
+		f.uploads[id] = &fakeMultipartUpload{key: key, sha256: r.Header.Get("X-Amz-Meta-Sha256"), parts: make(map[int][]byte), checksums: make(map[int]string)}
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>", key, id)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		upload, ok := f.uploads[query.Get("uploadId")]
+		partNumber, _ := strconv.Atoi(query.Get("partNumber"))
+		if !ok || upload.key != key || partNumber < 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("X-Amz-Checksum-Sha256") == "" {
+			http.Error(w, "part without a checksum", http.StatusBadRequest)
+			return
+		}
+		upload.parts[partNumber] = body
+		upload.checksums[partNumber] = fakeChecksum(body)
+		w.Header().Set("ETag", fmt.Sprintf(`"etag-%d"`, partNumber))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		id := query.Get("uploadId")
+		upload, ok := f.uploads[id]
+		if !ok || upload.key != key {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		var complete completeMultipartUpload
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var object []byte
+		var checksums []byte
+		for i, part := range complete.Parts {
+			data, ok := upload.parts[part.PartNumber]
+			if !ok || part.PartNumber != i+1 || part.ChecksumSHA256 != upload.checksums[part.PartNumber] {
+				http.Error(w, "InvalidPart", http.StatusBadRequest)
+				return
+			}
+			object = append(object, data...)
+			sum, _ := base64.StdEncoding.DecodeString(part.ChecksumSHA256)
+			checksums = append(checksums, sum...)
+		}
+		delete(f.uploads, id)
+		f.objects[key] = object
+		f.checksums[key] = fmt.Sprintf("%s-%d", fakeChecksum(checksums), len(complete.Parts))
+		f.sha256s[key] = upload.sha256
+		fmt.Fprintf(w, `<CompleteMultipartUploadResult><Key>%s</Key><ETag>"etag"</ETag></CompleteMultipartUploadResult>`, key)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(f.uploads, query.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		f.objects[key] = body
+		f.checksums[key] = r.Header.Get("X-Amz-Checksum-Sha256")
+		f.sha256s[key] = r.Header.Get("X-Amz-Meta-Sha256")
+		w.Header().Set("ETag", `"etag"`)
+	case r.Method == http.MethodGet, r.Method == http.MethodHead:
+		body, ok := f.objects[key]
+		if !ok || f.hideArchives && strings.HasSuffix(key, ".tar.gz") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		status := http.StatusOK
+		var start, end int
+		if _, err := fmt.Sscanf(r.Header.Get("Range"), "bytes=%d-%d", &start, &end); err == nil {
+			body = body[start : end+1]
+			status = http.StatusPartialContent
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			w.Write(body)
+		}
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+const testAdminToken = "admin-secret"
+
+// buildCrashme compiles testdata/crashme.c, a non-PIE binary with DWARF and a
+// build ID, for the symbolization tests.
+func buildCrashme(t *testing.T) string {
+	t.Helper()
+	gcc, err := exec.LookPath("gcc")
+	if err != nil {
+		t.Skip("gcc not found")
+	}
+	testdata, err := filepath.Abs("testdata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := filepath.Join(t.TempDir(), "crashme")
+	cmd := exec.Command(gcc, "-g", "-O0", "-no-pie", "-Wl,--build-id", "-fdebug-prefix-map="+testdata+"=.", "-o", out, "crashme.c")
+	cmd.Dir = testdata
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Skipf("gcc cannot build crashme.c: %v\n%s", err, output)
+	}
+	return out
+}
+
+func withToken(ctx context.Context, token string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
+
+type testEnv struct {
+	conn      *grpc.ClientConn
+	splunk    *fakeSplunk
+	s3        *fakeS3
+	collector *metrics.Collector
+	exporter  *metrics.DeviceExporter
+	checker   *health.Checker
+	sinkPath  string
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	t.Helper()
+	logger := zap.NewNop()
+
+	env := &testEnv{
+		splunk: &fakeSplunk{},
+		s3: &fakeS3{
+			objects:   make(map[string][]byte),
+			checksums: make(map[string]string),
+			sha256s:   make(map[string]string),
+			uploads:   make(map[string]*fakeMultipartUpload),
+		},
+	}
+
+	splunkSrv := httptest.NewServer(env.splunk)
+	t.Cleanup(splunkSrv.Close)
+	s3Srv := httptest.NewServer(env.s3)
+	t.Cleanup(s3Srv.Close)
+
+	splunkURL, _ := url.Parse(splunkSrv.URL)
+	splunkPort, _ := strconv.Atoi(splunkURL.Port())
+	splunkClient := splunk.NewClient(config.SplunkConfig{
+		Host:  splunkURL.Hostname(),
+		Port:  splunkPort,
+		Token: "test",
+	}, logger)
+
+	s3Client, err := storage.NewS3Client(context.Background(), config.S3Config{
+		Region:          "us-east-1",
+		Bucket:          "brahma",
+		AccessKeyID:     "test",
+		SecretAccessKey: "test",
+		Endpoint:        s3Srv.URL,
+	})
+	if err != nil {
+		t.Fatalf("NewS3Client: %v", err)
+	}
+
+	env.sinkPath = filepath.Join(t.TempDir(), "events.jsonl")
+	fileSink, err := sink.NewFileSink(env.sinkPath)
+	if err != nil {
+		t.Fatalf("NewFileSink: %v", err)
+	}
+	router, err := sink.NewRouter(map[string]sink.Sink{
+		sink.Splunk: sink.NewSplunkSink(splunkClient, nil, logger),
+		sink.File:   fileSink,
+	}, map[string][]string{
+		sink.DefaultRoute: {sink.Splunk},
+		"cpu_stats":       {sink.Splunk, sink.File},
+	})
+	if err != nil {
+		t.Fatalf("NewRouter: %v", err)
+	}
+	t.Cleanup(func() { router.Close() })
+
+	reg, err := registry.NewRegistry(config.RegistryConfig{}, registry.NewMemoryStore(), router, logger)
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+
+	logIndex, err := metrics.NewLogIndex("")
+	if err != nil {
+		t.Fatalf("NewLogIndex: %v", err)
+	}
+
+	env.exporter, err = metrics.NewDeviceExporter(config.MetricsConfig{}, reg)
+	if err != nil {
+		t.Fatalf("NewDeviceExporter: %v", err)
+	}
+
+	crashIndex, err := crash.NewIndex(config.CrashConfig{})
+	if err != nil {
+		t.Fatalf("NewIndex: %v", err)
+	}
+
+	symbolStore, err := symbols.NewStore(config.SymbolsConfig{Dir: t.TempDir()})
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+
+	env.collector = metrics.NewCollector(config.MetricsConfig{BufferSize: 1, Workers: 1}, router, s3Client, logIndex, crashIndex, symbolStore, env.exporter, logger)
+
+	env.checker = health.NewChecker(config.HealthConfig{}, splunkClient, s3Client, env.collector, logger)
+
+	srv, err := NewServer(config.GRPCConfig{AdminToken: testAdminToken}, env.collector, reg, env.checker, logger)
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	go srv.server.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	env.conn, err = grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	t.Cleanup(func() { env.conn.Close() })
+
+	return env
+}
+
+func TestRoundTrip(t *testing.T) {
+	env := newTestEnv(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	devices := brahmav1.NewDeviceServiceClient(env.conn)
+	metricsClient := brahmav1.NewMetricsServiceClient(env.conn)
+	logs := brahmav1.NewLogServiceClient(env.conn)
+	admin := brahmav1.NewAdminServiceClient(env.conn)
+	adminCtx := withToken(ctx, testAdminToken)
+
+	reg, err := devices.Register(ctx, &brahmav1.RegisterRequest{
+		ForeignKey: "switch-01",
+		Hostname:   "switch-01.example.net",
+		Platform:   "x86_64-accton_as7726_32x-r0",
+		Labels:     map[string]string{"site": "sjc1"},
+	})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	uid := reg.Uid
+	if uid == "" || reg.RegisteredAt == nil || reg.Token == "" {
+		t.Fatalf("Register returned %+v", reg)
+	}
+	deviceCtx := withToken(ctx, reg.Token)
+
+	t.Run("Health", func(t *testing.T) {
+		client := healthpb.NewHealthClient(env.conn)
+		check := func(service string, want healthpb.HealthCheckResponse_ServingStatus) {
+			t.Helper()
+			resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+			if err != nil {
+				t.Fatalf("Check(%q): %v", service, err)
+			}
+			if resp.Status != want {
+				t.Errorf("Check(%q) = %v, want %v", service, resp.Status, want)
+			}
+		}
+
+		check("", healthpb.HealthCheckResponse_NOT_SERVING)
+		env.checker.Check(ctx)
+		check("", healthpb.HealthCheckResponse_SERVING)
+		check("brahma.v1.MetricsService", healthpb.HealthCheckResponse_SERVING)
+
+		env.s3.setDenied(true)
+		env.checker.Check(ctx)
+		check("readiness", healthpb.HealthCheckResponse_NOT_SERVING)
+		check("brahma.v1.LogService", healthpb.HealthCheckResponse_NOT_SERVING)
+		check("brahma.v1.MetricsService", healthpb.HealthCheckResponse_SERVING)
+		check("liveness", healthpb.HealthCheckResponse_SERVING)
+
+		env.s3.setDenied(false)
+		env.checker.Check(ctx)
+		check("readiness", healthpb.HealthCheckResponse_SERVING)
+	})
+
+	t.Run("GetDevice", func(t *testing.T) {
+		device, err := devices.GetDevice(ctx, &brahmav1.GetDeviceRequest{Uid: uid})
+		if err != nil {
+			t.Fatalf("GetDevice: %v", err)
+		}
+		if device.ForeignKey != "switch-01" || device.Labels["site"] != "sjc1" || device.State != "online" {
+			t.Errorf("GetDevice returned %+v", device)
+		}
+	})
+
+	t.Run("ListDevices", func(t *testing.T) {
+		list, err := devices.ListDevices(ctx, &brahmav1.ListDevicesRequest{})
+		if err != nil {
+			t.Fatalf("ListDevices: %v", err)
+		}
+		if list.Total != 1 || len(list.Devices) != 1 || list.Devices[0].Uid != uid {
+			t.Errorf("ListDevices returned %+v", list)
+		}
+	})
+
+	t.Run("ListDevicesFiltered", func(t *testing.T) {
+		var extra []string
+		for _, req := range []*brahmav1.RegisterRequest{
+			{ForeignKey: "switch-02", Platform: "x86_64-mlnx_msn2700-r0", Labels: map[string]string{"site": "dc1", "role": "spine"}},
+			{ForeignKey: "switch-03", Platform: "x86_64-mlnx_msn2700-r0", Labels: map[string]string{"site": "dc2", "role": "spine", "deprecated": "true"}},
+			{ForeignKey: "switch-04", Platform: "x86_64-mlnx_msn2700-r0", Labels: map[string]string{"site": "dc2", "role": "leaf"}},
+		} {
+			resp, err := devices.Register(ctx, req)
+			if err != nil {
+				t.Fatalf("Register %s: %v", req.ForeignKey, err)
+			}
+			extra = append(extra, resp.Uid)
+		}
+		defer func() {
+			for _, uid := range extra {
+				devices.Unregister(adminCtx, &brahmav1.UnregisterRequest{Uid: uid})
+			}
+		}()
+
+		list, err := devices.ListDevices(ctx, &brahmav1.ListDevicesRequest{
+			LabelSelector: "role=spine,site in (dc1,dc2),!deprecated",
+		})
+		if err != nil {
+			t.Fatalf("ListDevices: %v", err)
+		}
+		if list.Total != 1 || list.Devices[0].ForeignKey != "switch-02" {
+			t.Errorf("selector matched %+v", list.Devices)
+		}
+
+		list, err = devices.ListDevices(ctx, &brahmav1.ListDevicesRequest{Platform: "x86_64-mlnx_msn2700-r0", LabelSelector: "role!=leaf"})
+		if err != nil {
+			t.Fatalf("ListDevices: %v", err)
+		}
+		if list.Total != 2 {
+			t.Errorf("platform and selector matched %d devices, want 2", list.Total)
+		}
+
+		list, err = devices.ListDevices(ctx, &brahmav1.ListDevicesRequest{State: "offline"})
+		if err != nil {
+			t.Fatalf("ListDevices: %v", err)
+		}
+		if list.Total != 0 {
+			t.Errorf("state=offline matched %d devices, want 0", list.Total)
+		}
+
+		_, err = devices.ListDevices(ctx, &brahmav1.ListDevicesRequest{State: "missing"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("unknown state: got %v, want InvalidArgument", err)
+		}
+
+		_, err = devices.ListDevices(ctx, &brahmav1.ListDevicesRequest{LabelSelector: "site in (dc1"})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("malformed selector: got %v, want InvalidArgument", err)
+		}
+
+		var seen []string
+		req := &brahmav1.ListDevicesRequest{Limit: 3}
+		for {
+			page, err := devices.ListDevices(ctx, req)
+			if err != nil {
+				t.Fatalf("ListDevices page: %v", err)
+			}
+			if page.Total != 4 {
+				t.Fatalf("page total = %d, want 4", page.Total)
+			}
+			for _, d := range page.Devices {
+				seen = append(seen, d.Uid)
+			}
+			if page.NextPageToken == "" {
+				break
+			}
+			req.PageToken = page.NextPageToken
+		}
+		if len(seen) != 4 || !sort.StringsAreSorted(seen) {
+			t.Errorf("paged through %v, want 4 devices in UID order", seen)
+		}
+	})
+
+	t.Run("WatchDevices", func(t *testing.T) {
+		watchCtx, stopWatch := context.WithCancel(ctx)
+		stream, err := devices.WatchDevices(watchCtx, &brahmav1.WatchDevicesRequest{LabelSelector: "site=sjc1"})
+		if err != nil {
+			t.Fatalf("WatchDevices: %v", err)
+		}
+
+		recv := func(want brahmav1.DeviceEventType) *brahmav1.DeviceEvent {
+			t.Helper()
+			event, err := stream.Recv()
+			if err != nil {
+				t.Fatalf("Recv: %v", err)
+			}
+			if event.Type != want {
+				t.Fatalf("got %v event, want %v", event.Type, want)
+			}
+			return event
+		}
+
+		if event := recv(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_ADDED); event.Device.Uid != uid {
+			t.Errorf("snapshot returned %s, want %s", event.Device.Uid, uid)
+		}
+		synced := recv(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_SYNCED)
+
+		// Not selected, so not reported.
+		other, err := devices.Register(ctx, &brahmav1.RegisterRequest{ForeignKey: "switch-05", Labels: map[string]string{"site": "dc1"}})
+		if err != nil {
+			t.Fatalf("Register: %v", err)
+		}
+		// Relabelled into the selection: ADDED.
+		if _, err := devices.Register(withToken(ctx, other.Token), &brahmav1.RegisterRequest{ForeignKey: "switch-05", Labels: map[string]string{"site": "sjc1"}}); err != nil {
+			t.Fatalf("Register: %v", err)
+		}
+		added := recv(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_ADDED)
+		if added.Device.Uid != other.Uid || added.Revision <= synced.Revision {
+			t.Errorf("ADDED event %+v after revision %d", added, synced.Revision)
+		}
+		stopWatch()
+
+		if _, err := devices.Unregister(adminCtx, &brahmav1.UnregisterRequest{Uid: other.Uid}); err != nil {
+			t.Fatalf("Unregister: %v", err)
+		}
+
+		stream, err = devices.WatchDevices(ctx, &brahmav1.WatchDevicesRequest{LabelSelector: "site=sjc1", ResumeRevision: added.Revision})
+		if err != nil {
+			t.Fatalf("WatchDevices resume: %v", err)
+		}
+		if event := recv(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_DELETED); event.Device.Uid != other.Uid {
+			t.Errorf("resumed watch deleted %s, want %s", event.Device.Uid, other.Uid)
+		}
+		recv(brahmav1.DeviceEventType_DEVICE_EVENT_TYPE_SYNCED)
+
+		stream, err = devices.WatchDevices(ctx, &brahmav1.WatchDevicesRequest{ResumeRevision: 1})
+		if err == nil {
+			_, err = stream.Recv()
+		}
+		if status.Code(err) != codes.OutOfRange {
+			t.Errorf("resume from compacted revision: got %v, want OutOfRange", err)
+		}
+	})
+
+	t.Run("Heartbeat", func(t *testing.T) {
+		resp, err := devices.Heartbeat(deviceCtx, &brahmav1.HeartbeatRequest{Uid: uid})
+		if err != nil {
+			t.Fatalf("Heartbeat: %v", err)
+		}
+		if resp.Status != "ok" || resp.ServerTime == nil {
+			t.Errorf("Heartbeat returned %+v", resp)
+		}
+	})
+
+	t.Run("ReportMetrics", func(t *testing.T) {
+		reports := map[string]func() (*brahmav1.MetricsResponse, error){
+			"ReportCPUStats": func() (*brahmav1.MetricsResponse, error) {
+				return metricsClient.ReportCPUStats(deviceCtx, &brahmav1.CPUStatsRequest{Uid: uid, UsagePercent: 12.5, PerCoreUsage: []float64{10, 15}})
+			},
+			"ReportProcessStats": func() (*brahmav1.MetricsResponse, error) {
+				return metricsClient.ReportProcessStats(deviceCtx, &brahmav1.ProcessStatsRequest{Uid: uid, TotalCount: 1, Processes: []*brahmav1.ProcessInfo{{Pid: 1, Name: "init"}}})
+			},
+			"ReportMgmtNetworkStats": func() (*brahmav1.MetricsResponse, error) {
+				return metricsClient.ReportMgmtNetworkStats(deviceCtx, &brahmav1.MgmtNetworkStatsRequest{Uid: uid, InterfaceName: "eth0", DnsServers: []string{"10.0.0.53"}})
+			},
+			"ReportRouterBaseState": func() (*brahmav1.MetricsResponse, error) {
+				return metricsClient.ReportRouterBaseState(deviceCtx, &brahmav1.RouterBaseStateRequest{Uid: uid, Hostname: "switch-01", LldpStatus: &brahmav1.LLDPStatus{Enabled: true}})
+			},
+			"ReportInterfaceStats": func() (*brahmav1.MetricsResponse, error) {
+				return metricsClient.ReportInterfaceStats(deviceCtx, &brahmav1.InterfaceStatsRequest{Uid: uid, Interfaces: []*brahmav1.InterfaceCounters{{Name: "Ethernet0", RxBytes: 42}}})
+			},
+			"ReportBGPNeighbors": func() (*brahmav1.MetricsResponse, error) {
+				return metricsClient.ReportBGPNeighbors(deviceCtx, &brahmav1.BGPNeighborsRequest{Uid: uid, Neighbors: []*brahmav1.BGPNeighbor{{NeighborIp: "10.0.0.1", NeighborAs: 65001}}})
+			},
+			"ReportVXLANTunnels": func() (*brahmav1.MetricsResponse, error) {
+				return metricsClient.ReportVXLANTunnels(deviceCtx, &brahmav1.VXLANTunnelsRequest{Uid: uid, Tunnels: []*brahmav1.VXLANTunnel{{Vni: 10010}}})
+			},
+			"ReportQoSQueues": func() (*brahmav1.MetricsResponse, error) {
+				return metricsClient.ReportQoSQueues(deviceCtx, &brahmav1.QoSQueuesRequest{Uid: uid, Queues: []*brahmav1.QoSQueue{{Interface: "Ethernet0", Queue: "UC3"}}})
+			},
+		}
+
+		for name, report := range reports {
+			resp, err := report()
+			if err != nil {
+				t.Errorf("%s: %v", name, err)
+				continue
+			}
+			if resp.Status != "accepted" || resp.Uid != uid {
+				t.Errorf("%s returned %+v", name, resp)
+			}
+		}
+	})
+
+	t.Run("DeviceMetrics", func(t *testing.T) {
+		expected := `
+# HELP brahma_device_cpu_usage_percent CPU usage reported by the device.
+# TYPE brahma_device_cpu_usage_percent gauge
+brahma_device_cpu_usage_percent{device_type="",hostname="switch-01.example.net",site="sjc1",uid="` + uid + `"} 12.5
+`
+		if err := testutil.CollectAndCompare(env.exporter, strings.NewReader(expected), "brahma_device_cpu_usage_percent"); err != nil {
+			t.Error(err)
+		}
+		if n := testutil.CollectAndCount(env.exporter, "brahma_device_mgmt_bytes_total"); n != 2 {
+			t.Errorf("got %d brahma_device_mgmt_bytes_total series, want 2", n)
+		}
+		if n := testutil.CollectAndCount(env.exporter, "brahma_device_info"); n != 1 {
+			t.Errorf("got %d brahma_device_info series, want 1", n)
+		}
+	})
+
+	t.Run("RequestID", func(t *testing.T) {
+		var header metadata.MD
+		reqCtx := metadata.AppendToOutgoingContext(deviceCtx, requestid.Header, "req-0001")
+		if _, err := metricsClient.ReportProcessStats(reqCtx, &brahmav1.ProcessStatsRequest{Uid: uid}, grpc.Header(&header)); err != nil {
+			t.Fatalf("ReportProcessStats: %v", err)
+		}
+		if got := header.Get(requestid.Header); len(got) != 1 || got[0] != "req-0001" {
+			t.Errorf("response %s = %v, want req-0001", requestid.Header, got)
+		}
+
+		header = nil
+		if _, err := devices.Heartbeat(deviceCtx, &brahmav1.HeartbeatRequest{Uid: uid}, grpc.Header(&header)); err != nil {
+			t.Fatalf("Heartbeat: %v", err)
+		}
+		if got := header.Get(requestid.Header); len(got) != 1 || got[0] == "" {
+			t.Errorf("no request ID generated, got %v", got)
+		}
+	})
+
+	t.Run("StreamMetrics", func(t *testing.T) {
+		stream, err := metricsClient.StreamMetrics(deviceCtx)
+		if err != nil {
+			t.Fatalf("StreamMetrics: %v", err)
+		}
+		requests := []*brahmav1.MetricsStreamRequest{
+			{Metrics: &brahmav1.MetricsStreamRequest_CpuStats{CpuStats: &brahmav1.CPUStatsRequest{Uid: uid}}},
+			{},
+			{Metrics: &brahmav1.MetricsStreamRequest_InterfaceStats{InterfaceStats: &brahmav1.InterfaceStatsRequest{Uid: uid}}},
+			{Metrics: &brahmav1.MetricsStreamRequest_QosQueues{QosQueues: &brahmav1.QoSQueuesRequest{Uid: uid}}},
+		}
+		for _, req := range requests {
+			if err := stream.Send(req); err != nil {
+				t.Fatalf("Send: %v", err)
+			}
+		}
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			t.Fatalf("CloseAndRecv: %v", err)
+		}
+		if resp.Uid != uid || resp.Message != "processed 3 metrics" {
+			t.Errorf("StreamMetrics returned %+v", resp)
+		}
+	})
+
+	var crashID string
+	crashContent := bytes.Repeat([]byte("#0 0x00007f main ()\n"), 5000)
+
+	t.Run("UploadCrashReport", func(t *testing.T) {
+		stream, err := logs.UploadCrashReport(deviceCtx)
+		if err != nil {
+			t.Fatalf("UploadCrashReport: %v", err)
+		}
+		if err := stream.Send(&brahmav1.CrashReportChunk{Data: &brahmav1.CrashReportChunk_Metadata{Metadata: &brahmav1.CrashReportMetadata{
+			Uid:        uid,
+			ProcessTag: "orchagent",
+			Filename:   "core.txt",
+		}}}); err != nil {
+			t.Fatalf("Send metadata: %v", err)
+		}
+		for i := 0; i < len(crashContent); i += 32 * 1024 {
+			end := i + 32*1024
+			if end > len(crashContent) {
+				end = len(crashContent)
+			}
+			if err := stream.Send(&brahmav1.CrashReportChunk{Data: &brahmav1.CrashReportChunk_Chunk{Chunk: crashContent[i:end]}}); err != nil {
+				t.Fatalf("Send chunk: %v", err)
+			}
+		}
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			t.Fatalf("CloseAndRecv: %v", err)
+		}
+		if resp.LogId == "" || resp.S3Key == "" {
+			t.Fatalf("UploadCrashReport returned %+v", resp)
+		}
+		crashID = resp.LogId
+	})
+
+	t.Run("UploadBacktrace", func(t *testing.T) {
+		stream, err := logs.UploadBacktrace(deviceCtx)
+		if err != nil {
+			t.Fatalf("UploadBacktrace: %v", err)
+		}
+		stream.Send(&brahmav1.BacktraceChunk{Data: &brahmav1.BacktraceChunk_Metadata{Metadata: &brahmav1.BacktraceMetadata{Uid: uid, ProcessTag: "syncd"}}})
+		stream.Send(&brahmav1.BacktraceChunk{Data: &brahmav1.BacktraceChunk_Chunk{Chunk: []byte("backtrace")}})
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			t.Fatalf("CloseAndRecv: %v", err)
+		}
+		if resp.LogId == "" {
+			t.Fatalf("UploadBacktrace returned %+v", resp)
+		}
+	})
+
+	t.Run("GetLogMetadata", func(t *testing.T) {
+		meta, err := logs.GetLogMetadata(deviceCtx, &brahmav1.GetLogMetadataRequest{LogId: crashID})
+		if err != nil {
+			t.Fatalf("GetLogMetadata: %v", err)
+		}
+		if meta.DeviceUid != uid || meta.LogType != "crash" || meta.ProcessTag != "orchagent" {
+			t.Errorf("GetLogMetadata returned %+v", meta)
+		}
+	})
+
+	t.Run("ListLogs", func(t *testing.T) {
+		list, err := logs.ListLogs(deviceCtx, &brahmav1.ListLogsRequest{Uid: uid})
+		if err != nil {
+			t.Fatalf("ListLogs: %v", err)
+		}
+		if list.Total != 2 || len(list.Logs) != 2 {
+			t.Errorf("ListLogs returned %d of %d logs", len(list.Logs), list.Total)
+		}
+
+		crashes, err := logs.ListLogs(deviceCtx, &brahmav1.ListLogsRequest{Uid: uid, LogType: "crash"})
+		if err != nil {
+			t.Fatalf("ListLogs: %v", err)
+		}
+		if crashes.Total != 1 || crashes.Logs[0].LogId != crashID {
+			t.Errorf("ListLogs(crash) returned %+v", crashes)
+		}
+	})
+
+	t.Run("DownloadLog", func(t *testing.T) {
+		stream, err := logs.DownloadLog(deviceCtx, &brahmav1.DownloadLogRequest{LogId: crashID})
+		if err != nil {
+			t.Fatalf("DownloadLog: %v", err)
+		}
+
+		first, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv metadata: %v", err)
+		}
+		if first.GetMetadata().GetLogId() != crashID {
+			t.Fatalf("first message is not metadata: %+v", first)
+		}
+
+		var content []byte
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("Recv chunk: %v", err)
+			}
+			content = append(content, msg.GetChunk()...)
+		}
+		if !bytes.Equal(content, crashContent) {
+			t.Errorf("downloaded %d bytes, want %d", len(content), len(crashContent))
+		}
+	})
+
+	t.Run("CrashBuckets", func(t *testing.T) {
+		uploadCrash := func(processTag, version, report string) string {
+			t.Helper()
+			stream, err := logs.UploadCrashReport(deviceCtx)
+			if err != nil {
+				t.Fatalf("UploadCrashReport: %v", err)
+			}
+			stream.Send(&brahmav1.CrashReportChunk{Data: &brahmav1.CrashReportChunk_Metadata{Metadata: &brahmav1.CrashReportMetadata{Uid: uid, ProcessTag: processTag, Version: version}}})
+			stream.Send(&brahmav1.CrashReportChunk{Data: &brahmav1.CrashReportChunk_Chunk{Chunk: []byte(report)}})
+			resp, err := stream.CloseAndRecv()
+			if err != nil {
+				t.Fatalf("CloseAndRecv: %v", err)
+			}
+			meta, err := logs.GetLogMetadata(deviceCtx, &brahmav1.GetLogMetadataRequest{LogId: resp.LogId})
+			if err != nil {
+				t.Fatalf("GetLogMetadata: %v", err)
+			}
+			return meta.Signature
+		}
+
+		first := uploadCrash("orchagent[1234]", "202305.1", `Program terminated with signal SIGABRT, Aborted.
+#0  0x00007f3a2b1c4e97 in raise () from /lib/x86_64-linux-gnu/libc.so.6
+#1  0x00007f3a2b1c6801 in abort () from /lib/x86_64-linux-gnu/libc.so.6
+#2  0x000055d5c8a1b2c3 in swss::Orch::doTask (this=0x55d5c9e0a010, consumer=...) at orch.cpp:123
+#3  0x000055d5c8a1c000 in swss::OrchDaemon::start (this=0x55d5c9e00000) at orchdaemon.cpp:456
+#4  0x000055d5c8a10000 in main (argc=1, argv=0x7ffd4b2c1e48) at main.cpp:78
+`)
+		second := uploadCrash("orchagent[5678]", "202305.2", `#0  0x00007f0000000e97 in raise () from /lib/x86_64-linux-gnu/libc.so.6
+#1  0x00007f0000000801 in abort () from /lib/x86_64-linux-gnu/libc.so.6
+#2  0x0000560000000abc in swss::Orch::doTask (this=0x560000001000, consumer=...) at orch.cpp:125
+#3  0x0000560000000def in swss::OrchDaemon::start (this=0x560000002000) at orchdaemon.cpp:460
+#4  0x0000560000000123 in main (argc=1, argv=0x7ffe00000000) at main.cpp:78
+`)
+		other := uploadCrash("orchagent", "202305.1", "/usr/bin/orchagent(_ZN4swss8PortsOrch6doTaskEv+0x1a3) [0x55d5c8a1b2c3]\n")
+		none := uploadCrash("orchagent", "202305.1", "no backtrace here\n")
+
+		if first == "" || first != second {
+			t.Fatalf("same crash got signatures %q and %q", first, second)
+		}
+		if other == "" || other == first {
+			t.Errorf("different crash got signature %q, first was %q", other, first)
+		}
+		if none != "" {
+			t.Errorf("report without a backtrace got signature %q", none)
+		}
+
+		bucket, err := admin.GetCrashBucket(adminCtx, &brahmav1.GetCrashBucketRequest{Signature: first})
+		if err != nil {
+			t.Fatalf("GetCrashBucket: %v", err)
+		}
+		wantFrames := []string{"swss::Orch::doTask", "swss::OrchDaemon::start", "main"}
+		if bucket.Count != 2 || bucket.ProcessTag != "orchagent" || strings.Join(bucket.Frames, ",") != strings.Join(wantFrames, ",") {
+			t.Errorf("GetCrashBucket returned %+v", bucket)
+		}
+		if len(bucket.DeviceUids) != 1 || len(bucket.Versions) != 2 || bucket.FirstSeen.AsTime().After(bucket.LastSeen.AsTime()) {
+			t.Errorf("bucket devices %v, versions %v, seen %v to %v", bucket.DeviceUids, bucket.Versions, bucket.FirstSeen.AsTime(), bucket.LastSeen.AsTime())
+		}
+
+		list, err := admin.ListCrashBuckets(adminCtx, &brahmav1.ListCrashBucketsRequest{ProcessTag: "orchagent"})
+		if err != nil {
+			t.Fatalf("ListCrashBuckets: %v", err)
+		}
+		if list.Total != 3 || len(list.Buckets) != 3 {
+			t.Errorf("ListCrashBuckets returned %d of %d buckets, want 3", len(list.Buckets), list.Total)
+		}
+
+		if _, err := admin.ListCrashBuckets(deviceCtx, &brahmav1.ListCrashBucketsRequest{}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("ListCrashBuckets with a device token: got %v, want PermissionDenied", err)
+		}
+		if _, err := admin.GetCrashBucket(adminCtx, &brahmav1.GetCrashBucketRequest{Signature: "missing"}); status.Code(err) != codes.NotFound {
+			t.Errorf("GetCrashBucket for a missing bucket: got %v, want NotFound", err)
+		}
+	})
+
+	t.Run("ParseCrashArtifacts", func(t *testing.T) {
+		upload := func(processTag, report string) *brahmav1.LogMetadataResponse {
+			t.Helper()
+			stream, err := logs.UploadCrashReport(deviceCtx)
+			if err != nil {
+				t.Fatalf("UploadCrashReport: %v", err)
+			}
+			stream.Send(&brahmav1.CrashReportChunk{Data: &brahmav1.CrashReportChunk_Metadata{Metadata: &brahmav1.CrashReportMetadata{Uid: uid, ProcessTag: processTag, Version: "202305.1"}}})
+			stream.Send(&brahmav1.CrashReportChunk{Data: &brahmav1.CrashReportChunk_Chunk{Chunk: []byte(report)}})
+			resp, err := stream.CloseAndRecv()
+			if err != nil {
+				t.Fatalf("CloseAndRecv: %v", err)
+			}
+			meta, err := logs.GetLogMetadata(deviceCtx, &brahmav1.GetLogMetadataRequest{LogId: resp.LogId})
+			if err != nil {
+				t.Fatalf("GetLogMetadata: %v", err)
+			}
+			return meta
+		}
+
+		for _, tc := range []struct {
+			name, processTag, report string
+			want                     *brahmav1.LogMetadataResponse
+		}{
+			{
+				name:       "gdb bt full",
+				processTag: "syncd",
+				report: `Program terminated with signal SIGSEGV, Segmentation fault.
+#0  0x000055d5c8a1b2c3 in sai_get_port_attribute (port_id=0) at sai_port.c:88
+[Current thread is 1 (Thread 0x7f3a2b1c4700 (LWP 1234))]
+(gdb) bt full
+#0  0x000055d5c8a1b2c3 in sai_get_port_attribute (port_id=0) at sai_port.c:88
+        attr = 0x0
+#1  0x000055d5c8a1c000 in syncd::Syncd::processEvent (this=0x55d5c9e00000) at Syncd.cpp:456
+        i = 3
+`,
+				want: &brahmav1.LogMetadataResponse{Format: "gdb", Signal: "SIGSEGV", Thread: "1 (LWP 1234)", TopFrame: "sai_get_port_attribute", Frames: []string{"sai_get_port_attribute", "syncd::Syncd::processEvent"}},
+			},
+			{
+				name:       "glibc abort",
+				processTag: "orchagent",
+				report: "orchagent: orch.cpp:123: void swss::Orch::doTask(): Assertion `x' failed.\n" +
+					"/lib/x86_64-linux-gnu/libc.so.6(abort+0x121) [0x7f3a2b1c6801]\n" +
+					"/usr/bin/orchagent(_ZN4swss4Orch6doTaskEv+0x1a3) [0x55d5c8a1b2c3]\n",
+				want: &brahmav1.LogMetadataResponse{Format: "glibc", Signal: "SIGABRT", Exception: "Assertion `x' failed", TopFrame: "_ZN4swss4Orch6doTaskEv", Frames: []string{"abort", "_ZN4swss4Orch6doTaskEv"}},
+			},
+			{
+				name:       "kernel oops",
+				processTag: "kernel",
+				report: `[ 1234.567890] BUG: kernel NULL pointer dereference, address: 0000000000000008
+[ 1234.567892] Oops: 0000 [#1] SMP NOPTI
+[ 1234.567893] CPU: 3 PID: 1234 Comm: syncd Tainted: P           OE     5.10.0-18-2-amd64 #1 Debian 5.10.140-1
+[ 1234.567894] RIP: 0010:sx_core_ioctl+0x1a/0x30 [sx_core]
+[ 1234.567895] Call Trace:
+[ 1234.567896]  <TASK>
+[ 1234.567897]  ? sx_core_guess+0x10/0x20 [sx_core]
+[ 1234.567898]  __x64_sys_ioctl+0x8b/0xc0
+[ 1234.567899]  </TASK>
+[ 1234.567900] Kernel panic - not syncing: Fatal exception
+`,
+				want: &brahmav1.LogMetadataResponse{Format: "kernel", Thread: "syncd (PID 1234, CPU 3)", Exception: "BUG: kernel NULL pointer dereference, address: 0000000000000008", Taint: "POE", TopFrame: "sx_core_ioctl", Frames: []string{"sx_core_ioctl", "__x64_sys_ioctl"}},
+			},
+			{
+				name:       "python traceback",
+				processTag: "show",
+				report: `Traceback (most recent call last):
+  File "/usr/local/bin/show", line 8, in <module>
+    sys.exit(cli())
+  File "/usr/local/lib/python3.9/dist-packages/show/interfaces.py", line 42, in status
+    port = ports[name]
+KeyError: 'Ethernet0'
+`,
+				want: &brahmav1.LogMetadataResponse{Format: "python", Exception: "KeyError: 'Ethernet0'", TopFrame: "status", Frames: []string{"status", "<module>"}},
+			},
+			{
+				name:       "go panic",
+				processTag: "telemetry",
+				report: `panic: runtime error: invalid memory address or nil pointer dereference
+[signal SIGSEGV: segmentation violation code=0x1 addr=0x0 pc=0x4a1b2c]
+
+goroutine 42 [running]:
+main.(*Server).handle(0x0, {0x1, 0x2})
+	/go/src/telemetry/server.go:123 +0x2c
+created by main.run
+	/go/src/telemetry/main.go:60 +0x88
+`,
+				want: &brahmav1.LogMetadataResponse{Format: "go", Signal: "SIGSEGV", Thread: "goroutine 42", Exception: "runtime error: invalid memory address or nil pointer dereference", TopFrame: "main.(*Server).handle", Frames: []string{"main.(*Server).handle"}},
+			},
+		} {
+			meta := upload(tc.processTag, tc.report)
+			got := []string{meta.Format, meta.Signal, meta.Thread, meta.Exception, meta.Taint, meta.TopFrame, strings.Join(meta.Frames, ",")}
+			want := []string{tc.want.Format, tc.want.Signal, tc.want.Thread, tc.want.Exception, tc.want.Taint, tc.want.TopFrame, strings.Join(tc.want.Frames, ",")}
+			if strings.Join(got, "|") != strings.Join(want, "|") {
+				t.Errorf("%s: got %q, want %q", tc.name, got, want)
+			}
+
+			event := env.splunk.find("log_id", meta.LogId)
+			if event == nil || event["format"] != tc.want.Format || event["top_frame"] != tc.want.TopFrame {
+				t.Errorf("%s: log_metadata event %v", tc.name, event)
+			}
+		}
+
+		if meta := upload("orchagent", "no backtrace here\n"); meta.Format != "" || len(meta.Frames) != 0 {
+			t.Errorf("unrecognized report parsed as %q with frames %v", meta.Format, meta.Frames)
+		}
+	})
+
+	t.Run("Symbolize", func(t *testing.T) {
+		uploadSymbols := func(filename string, content []byte) (*brahmav1.UploadSymbolsResponse, error) {
+			stream, err := admin.UploadSymbols(adminCtx)
+			if err != nil {
+				t.Fatalf("UploadSymbols: %v", err)
+			}
+			stream.Send(&brahmav1.SymbolBundleChunk{Data: &brahmav1.SymbolBundleChunk_Metadata{Metadata: &brahmav1.SymbolBundleMetadata{Version: "202305.1", ProcessTag: "crashme", Filename: filename}}})
+			stream.Send(&brahmav1.SymbolBundleChunk{Data: &brahmav1.SymbolBundleChunk_Chunk{Chunk: content}})
+			return stream.CloseAndRecv()
+		}
+
+		if _, err := uploadSymbols("notes.txt", []byte("not symbols")); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UploadSymbols with a text file: got %v, want InvalidArgument", err)
+		}
+
+		crashme := buildCrashme(t)
+		binary, err := os.ReadFile(crashme)
+		if err != nil {
+			t.Fatalf("ReadFile: %v", err)
+		}
+		resp, err := uploadSymbols("crashme", binary)
+		if err != nil {
+			t.Fatalf("UploadSymbols: %v", err)
+		}
+		if len(resp.Files) != 1 || resp.Files[0] != "crashme" {
+			t.Errorf("UploadSymbols stored %v", resp.Files)
+		}
+
+		file, err := elf.Open(crashme)
+		if err != nil {
+			t.Fatalf("elf.Open: %v", err)
+		}
+		defer file.Close()
+		syms, _ := file.Symbols()
+		var doTask uint64
+		for _, sym := range syms {
+			if sym.Name == "do_task" {
+				doTask = sym.Value
+			}
+		}
+		note, _ := file.Section(".note.gnu.build-id").Data()
+		buildID := hex.EncodeToString(note[len(note)-20:])
+
+		// Return addresses inside do_task, as gdb and glibc print them for a
+		// stripped, non-PIE binary loaded at 0x400000.
+		backtrace := fmt.Sprintf(`Program terminated with signal SIGABRT, Aborted.
+#0  0x00007f3a2b1c4e97 in raise () from /lib/x86_64-linux-gnu/libc.so.6
+#1  0x%016x in ?? ()
+#2  0x00007f3a2b1c6801 in ?? () from /lib/x86_64-linux-gnu/libc.so.6
+/usr/bin/crashme(+0x%x) [0x%x]
+/opt/unknown(+0x%x) [0x%x] BuildId: %s
+`, doTask+8, doTask+8-0x400000, doTask+8, doTask+8-0x400000, doTask+8, buildID)
+
+		stream, err := logs.UploadBacktrace(deviceCtx)
+		if err != nil {
+			t.Fatalf("UploadBacktrace: %v", err)
+		}
+		stream.Send(&brahmav1.BacktraceChunk{Data: &brahmav1.BacktraceChunk_Metadata{Metadata: &brahmav1.BacktraceMetadata{Uid: uid, ProcessTag: "crashme[42]", Version: "202305.1"}}})
+		stream.Send(&brahmav1.BacktraceChunk{Data: &brahmav1.BacktraceChunk_Chunk{Chunk: []byte(backtrace)}})
+		upload, err := stream.CloseAndRecv()
+		if err != nil {
+			t.Fatalf("CloseAndRecv: %v", err)
+		}
+
+		meta, err := logs.GetLogMetadata(deviceCtx, &brahmav1.GetLogMetadataRequest{LogId: upload.LogId})
+		if err != nil {
+			t.Fatalf("GetLogMetadata: %v", err)
+		}
+		if meta.SymbolizedS3Key != meta.S3Key+".symbolized" {
+			t.Fatalf("symbolized_s3_key = %q for %q", meta.SymbolizedS3Key, meta.S3Key)
+		}
+		// The symbolized copy is what gets parsed.
+		if meta.Format != "gdb" || meta.Signal != "SIGABRT" || meta.TopFrame != "do_task" {
+			t.Errorf("symbolized backtrace parsed as %q, signal %q, top frame %q", meta.Format, meta.Signal, meta.TopFrame)
+		}
+
+		download := func(symbolized bool) string {
+			t.Helper()
+			stream, err := logs.DownloadLog(deviceCtx, &brahmav1.DownloadLogRequest{LogId: upload.LogId, Symbolized: symbolized})
+			if err != nil {
+				t.Fatalf("DownloadLog: %v", err)
+			}
+			var content []byte
+			for {
+				chunk, err := stream.Recv()
+				if err == io.EOF {
+					return string(content)
+				}
+				if err != nil {
+					t.Fatalf("Recv: %v", err)
+				}
+				content = append(content, chunk.GetChunk()...)
+			}
+		}
+
+		if raw := download(false); raw != backtrace {
+			t.Errorf("raw backtrace changed:\n%s", raw)
+		}
+		lines := strings.Split(download(true), "\n")
+		want := []string{
+			"Program terminated with signal SIGABRT, Aborted.",
+			"#0  0x00007f3a2b1c4e97 in raise () from /lib/x86_64-linux-gnu/libc.so.6",
+			fmt.Sprintf("#1  0x%016x in do_task () at crashme.c:", doTask+8),
+			"#2  0x00007f3a2b1c6801 in ?? () from /lib/x86_64-linux-gnu/libc.so.6",
+			fmt.Sprintf("#0  0x%016x in do_task () at crashme.c:", doTask+8),
+			fmt.Sprintf("#1  0x%016x in do_task () at crashme.c:", doTask+8),
+		}
+		for i, prefix := range want {
+			if i >= len(lines) || !strings.HasPrefix(lines[i], prefix) {
+				t.Errorf("symbolized line %d = %q, want prefix %q", i, lines[min(i, len(lines)-1)], prefix)
+			}
+		}
+
+		dl, err := logs.DownloadLog(deviceCtx, &brahmav1.DownloadLogRequest{LogId: crashID, Symbolized: true})
+		if err == nil {
+			_, err = dl.Recv()
+		}
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("symbolized download of a crash report: got %v, want NotFound", err)
+		}
+	})
+
+	t.Run("TechSupport", func(t *testing.T) {
+		dir := "sonic_dump_sonic_20261017_101010/"
+		members := []struct{ name, kind, content string }{
+			{dir + "log/syslog", "syslog", "Oct 17 10:10:10 sonic swss#orchagent: :- main: Orchagent started\n"},
+			{dir + "etc/sonic/config_db.json", "config_db", `{"DEVICE_METADATA": {"localhost": {"hostname": "sonic"}}}`},
+			{dir + "dump/APPL_DB.json", "redis_dump", `{"PORT_TABLE:Ethernet0": {"admin_status": "up"}}`},
+			{dir + "core/orchagent.1697537410.1234.core.gz", "core", string(bytes.Repeat([]byte{0x1f, 0x8b, 0}, 4000))},
+			{dir + "proc/empty", "other", ""},
+		}
+
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		tw.WriteHeader(&tar.Header{Name: dir, Typeflag: tar.TypeDir, Mode: 0o755})
+		for _, m := range members {
+			tw.WriteHeader(&tar.Header{Name: m.name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(m.content)), ModTime: time.Unix(1697537410, 0)})
+			tw.Write([]byte(m.content))
+		}
+		tw.Close()
+		gz.Close()
+		archive := buf.Bytes()
+
+		upload := func(archive []byte) *brahmav1.LogMetadataResponse {
+			t.Helper()
+			stream, err := logs.UploadTechSupport(deviceCtx)
+			if err != nil {
+				t.Fatalf("UploadTechSupport: %v", err)
+			}
+			stream.Send(&brahmav1.TechSupportChunk{Data: &brahmav1.TechSupportChunk_Metadata{Metadata: &brahmav1.TechSupportMetadata{Uid: uid, Version: "202305.1", Filename: "sonic_dump_sonic_20261017_101010.tar.gz"}}})
+			for len(archive) > 0 {
+				n := min(len(archive), 1000)
+				stream.Send(&brahmav1.TechSupportChunk{Data: &brahmav1.TechSupportChunk_Chunk{Chunk: archive[:n]}})
+				archive = archive[n:]
+			}
+			resp, err := stream.CloseAndRecv()
+			if err != nil {
+				t.Fatalf("CloseAndRecv: %v", err)
+			}
+
+			// The archive is indexed in the background once stored.
+			deadline := time.Now().Add(5 * time.Second)
+			for {
+				_, err := logs.GetTechSupportManifest(deviceCtx, &brahmav1.GetTechSupportManifestRequest{LogId: resp.LogId})
+				if status.Code(err) != codes.Unavailable {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatalf("archive not indexed: %v", err)
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+
+			meta, err := logs.GetLogMetadata(deviceCtx, &brahmav1.GetLogMetadataRequest{LogId: resp.LogId})
+			if err != nil {
+				t.Fatalf("GetLogMetadata: %v", err)
+			}
+			return meta
+		}
+
+		meta := upload(archive)
+		if meta.LogType != "techsupport" || meta.MemberCount != int32(len(members)) {
+			t.Fatalf("techsupport metadata %+v", meta)
+		}
+		env.s3.mu.Lock()
+		stored := env.s3.objects["brahma/"+meta.S3Key]
+		env.s3.mu.Unlock()
+		if !bytes.Equal(stored, archive) {
+			t.Errorf("stored archive has %d bytes, want %d", len(stored), len(archive))
+		}
+
+		manifest, err := logs.GetTechSupportManifest(deviceCtx, &brahmav1.GetTechSupportManifestRequest{LogId: meta.LogId})
+		if err != nil {
+			t.Fatalf("GetTechSupportManifest: %v", err)
+		}
+		if len(manifest.Members) != len(members) || manifest.Error != "" {
+			t.Fatalf("manifest has %d members, error %q", len(manifest.Members), manifest.Error)
+		}
+		for i, m := range members {
+			got := manifest.Members[i]
+			if got.Path != m.name || got.Kind != m.kind || got.Size != int64(len(m.content)) || !got.ModTime.AsTime().Equal(time.Unix(1697537410, 0)) {
+				t.Errorf("member %d = %+v, want %s (%s, %d bytes)", i, got, m.name, m.kind, len(m.content))
+			}
+		}
+
+		download := func(logID, member string) (string, error) {
+			t.Helper()
+			stream, err := logs.DownloadLog(deviceCtx, &brahmav1.DownloadLogRequest{LogId: logID, Member: member})
+			if err != nil {
+				return "", err
+			}
+			var content []byte
+			for {
+				msg, err := stream.Recv()
+				if err == io.EOF {
+					return string(content), nil
+				}
+				if err != nil {
+					return "", err
+				}
+				content = append(content, msg.GetChunk()...)
+			}
+		}
+		for _, m := range members {
+			content, err := download(meta.LogId, m.name)
+			if err != nil || content != m.content {
+				t.Errorf("member %s: got %d bytes, err %v, want %d bytes", m.name, len(content), err, len(m.content))
+			}
+		}
+		// The escalation members are copied out of the archive, so they
+		// can still be read without it.
+		env.s3.setHideArchives(true)
+		for _, m := range members {
+			content, err := download(meta.LogId, m.name)
+			if copied := m.kind != "other"; copied != (err == nil && content == m.content) {
+				t.Errorf("member %s without the archive: got %d bytes, err %v", m.name, len(content), err)
+			}
+		}
+		env.s3.setHideArchives(false)
+		if _, err := download(meta.LogId, dir+"log/missing"); status.Code(err) != codes.NotFound {
+			t.Errorf("download of a missing member: got %v, want NotFound", err)
+		}
+		if _, err := download(crashID, "log/syslog"); status.Code(err) != codes.NotFound {
+			t.Errorf("member download of a crash report: got %v, want NotFound", err)
+		}
+
+		// A truncated archive is stored, with the members before the cut.
+		truncated := upload(archive[:len(archive)/2])
+		partial, err := logs.GetTechSupportManifest(deviceCtx, &brahmav1.GetTechSupportManifestRequest{LogId: truncated.LogId})
+		if err != nil {
+			t.Fatalf("GetTechSupportManifest: %v", err)
+		}
+		if partial.Error == "" || len(partial.Members) == 0 || len(partial.Members) >= len(members) {
+			t.Errorf("truncated archive manifest has %d members, error %q", len(partial.Members), partial.Error)
+		}
+
+		// An archive that cannot be read back is not retried forever.
+		env.s3.setHideArchives(true)
+		missing := upload(archive)
+		env.s3.setHideArchives(false)
+		_, err = logs.GetTechSupportManifest(deviceCtx, &brahmav1.GetTechSupportManifestRequest{LogId: missing.LogId})
+		if status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("manifest of an archive that failed indexing: got %v, want FailedPrecondition", err)
+		}
+		if _, err := download(missing.LogId, members[0].name); status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("member of an archive that failed indexing: got %v, want FailedPrecondition", err)
+		}
+	})
+
+	t.Run("Integrity", func(t *testing.T) {
+		content := []byte("#0  0x00007f3a2b1c4e97 in raise () from /lib/x86_64-linux-gnu/libc.so.6\n")
+		sum := sha256.Sum256(content)
+		digest := hex.EncodeToString(sum[:])
+
+		upload := func(size int64, sha string, chunks ...[]byte) (*brahmav1.LogUploadResponse, error) {
+			t.Helper()
+			stream, err := logs.UploadBacktrace(deviceCtx)
+			if err != nil {
+				t.Fatalf("UploadBacktrace: %v", err)
+			}
+			stream.Send(&brahmav1.BacktraceChunk{Data: &brahmav1.BacktraceChunk_Metadata{Metadata: &brahmav1.BacktraceMetadata{Uid: uid, ProcessTag: "orchagent", Size: size, Sha256: sha}}})
+			for _, chunk := range chunks {
+				stream.Send(&brahmav1.BacktraceChunk{Data: &brahmav1.BacktraceChunk_Chunk{Chunk: chunk}})
+			}
+			return stream.CloseAndRecv()
+		}
+
+		resp, err := upload(int64(len(content)), strings.ToUpper(digest), content[:10], content[10:])
+		if err != nil {
+			t.Fatalf("upload with a matching size and digest: %v", err)
+		}
+		meta, err := logs.GetLogMetadata(deviceCtx, &brahmav1.GetLogMetadataRequest{LogId: resp.LogId})
+		if err != nil {
+			t.Fatalf("GetLogMetadata: %v", err)
+		}
+		if meta.Size != int64(len(content)) || meta.Sha256 != digest {
+			t.Errorf("metadata size %d, sha256 %q; want %d, %q", meta.Size, meta.Sha256, len(content), digest)
+		}
+
+		// Undeclared digests are computed all the same. S3 gets the checksum
+		// of every object and keeps the digest as its metadata.
+		crashMeta, err := logs.GetLogMetadata(deviceCtx, &brahmav1.GetLogMetadataRequest{LogId: crashID})
+		if err != nil {
+			t.Fatalf("GetLogMetadata: %v", err)
+		}
+		crashSum := sha256.Sum256(crashContent)
+		env.s3.mu.Lock()
+		stored, crashStored := env.s3.checksums["brahma/"+meta.S3Key], env.s3.checksums["brahma/"+crashMeta.S3Key]
+		storedSHA256, crashSHA256 := env.s3.sha256s["brahma/"+meta.S3Key], env.s3.sha256s["brahma/"+crashMeta.S3Key]
+		objects := len(env.s3.objects)
+		env.s3.mu.Unlock()
+		if stored != fakeChecksum(content) || crashStored != fakeChecksum(crashContent) || crashMeta.Sha256 != hex.EncodeToString(crashSum[:]) {
+			t.Errorf("S3 checksums %q and %q, log metadata sha256 %q", stored, crashStored, crashMeta.Sha256)
+		}
+		if storedSHA256 != digest || crashSHA256 != crashMeta.Sha256 {
+			t.Errorf("S3 sha256 metadata %q and %q, want %q and %q", storedSHA256, crashSHA256, digest, crashMeta.Sha256)
+		}
+
+		for name, tc := range map[string]struct {
+			size   int64
+			sha    string
+			chunks [][]byte
+			code   codes.Code
+		}{
+			"truncated":    {int64(len(content)), digest, [][]byte{content[:10]}, codes.DataLoss},
+			"wrong digest": {0, strings.Repeat("0", 64), [][]byte{content}, codes.DataLoss},
+			"bad digest":   {0, "abc", [][]byte{content}, codes.InvalidArgument},
+			"bad size":     {-1, "", [][]byte{content}, codes.InvalidArgument},
+			"too large":    {storage.MaxObjectSize + 1, "", [][]byte{content}, codes.InvalidArgument},
+		} {
+			if _, err := upload(tc.size, tc.sha, tc.chunks...); status.Code(err) != tc.code {
+				t.Errorf("%s: got %v, want %v", name, err, tc.code)
+			}
+		}
+		env.s3.mu.Lock()
+		left := len(env.s3.objects) - objects
+		env.s3.mu.Unlock()
+		if left != 0 {
+			t.Errorf("%d objects stored by rejected uploads", left)
+		}
+	})
+
+	t.Run("Multipart", func(t *testing.T) {
+		content := bytes.Repeat([]byte("orchagent: heartbeat missed\n"), (2*storage.PartSize+1000)/28)
+		sum := sha256.Sum256(content)
+		digest := hex.EncodeToString(sum[:])
+
+		upload := func(sha string) (*brahmav1.LogUploadResponse, error) {
+			t.Helper()
+			stream, err := logs.UploadBacktrace(deviceCtx)
+			if err != nil {
+				t.Fatalf("UploadBacktrace: %v", err)
+			}
+			stream.Send(&brahmav1.BacktraceChunk{Data: &brahmav1.BacktraceChunk_Metadata{Metadata: &brahmav1.BacktraceMetadata{Uid: uid, ProcessTag: "orchagent", Size: int64(len(content)), Sha256: sha}}})
+			for rest := content; len(rest) > 0; {
+				n := min(len(rest), 1<<20)
+				stream.Send(&brahmav1.BacktraceChunk{Data: &brahmav1.BacktraceChunk_Chunk{Chunk: rest[:n]}})
+				rest = rest[n:]
+			}
+			return stream.CloseAndRecv()
+		}
+
+		resp, err := upload(digest)
+		if err != nil {
+			t.Fatalf("multipart upload: %v", err)
+		}
+		meta, err := logs.GetLogMetadata(deviceCtx, &brahmav1.GetLogMetadataRequest{LogId: resp.LogId})
+		if err != nil {
+			t.Fatalf("GetLogMetadata: %v", err)
+		}
+		if meta.Size != int64(len(content)) || meta.Sha256 != digest {
+			t.Errorf("metadata size %d, sha256 %q; want %d, %q", meta.Size, meta.Sha256, len(content), digest)
+		}
+
+		env.s3.mu.Lock()
+		stored := env.s3.objects["brahma/"+meta.S3Key]
+		checksum := env.s3.checksums["brahma/"+meta.S3Key]
+		storedSHA256 := env.s3.sha256s["brahma/"+meta.S3Key]
+		objects := len(env.s3.objects)
+		env.s3.mu.Unlock()
+		if !bytes.Equal(stored, content) {
+			t.Errorf("stored object has %d bytes, want %d", len(stored), len(content))
+		}
+		if !strings.HasSuffix(checksum, "-3") {
+			t.Errorf("S3 checksum %q, want the checksum of 3 parts", checksum)
+		}
+		if storedSHA256 != digest {
+			t.Errorf("S3 sha256 metadata %q, want the declared %q", storedSHA256, digest)
+		}
+
+		// A mismatch found after parts were sent aborts the multipart upload.
+		if _, err := upload(strings.Repeat("0", 64)); status.Code(err) != codes.DataLoss {
+			t.Fatalf("multipart upload with a wrong digest: got %v, want DataLoss", err)
+		}
+		env.s3.mu.Lock()
+		left, pending := len(env.s3.objects)-objects, len(env.s3.uploads)
+		env.s3.mu.Unlock()
+		if left != 0 || pending != 0 {
+			t.Errorf("rejected multipart upload left %d objects and %d open uploads", left, pending)
+		}
+	})
+
+	t.Run("Auth", func(t *testing.T) {
+		_, err := metricsClient.ReportCPUStats(ctx, &brahmav1.CPUStatsRequest{Uid: uid})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("without token: got %v, want Unauthenticated", err)
+		}
+
+		_, err = metricsClient.ReportCPUStats(withToken(ctx, uid+".forged"), &brahmav1.CPUStatsRequest{Uid: uid})
+		if status.Code(err) != codes.Unauthenticated {
+			t.Errorf("forged token: got %v, want Unauthenticated", err)
+		}
+
+		other, err := devices.Register(ctx, &brahmav1.RegisterRequest{ForeignKey: "switch-06"})
+		if err != nil {
+			t.Fatalf("Register: %v", err)
+		}
+		defer devices.Unregister(adminCtx, &brahmav1.UnregisterRequest{Uid: other.Uid})
+		otherCtx := withToken(ctx, other.Token)
+
+		_, err = metricsClient.ReportCPUStats(otherCtx, &brahmav1.CPUStatsRequest{Uid: uid})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("another device's token: got %v, want PermissionDenied", err)
+		}
+
+		stream, err := metricsClient.StreamMetrics(otherCtx)
+		if err != nil {
+			t.Fatalf("StreamMetrics: %v", err)
+		}
+		stream.Send(&brahmav1.MetricsStreamRequest{Metrics: &brahmav1.MetricsStreamRequest_CpuStats{CpuStats: &brahmav1.CPUStatsRequest{Uid: other.Uid}}})
+		stream.Send(&brahmav1.MetricsStreamRequest{Metrics: &brahmav1.MetricsStreamRequest_CpuStats{CpuStats: &brahmav1.CPUStatsRequest{Uid: uid}}})
+		if _, err := stream.CloseAndRecv(); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("switching UID mid-stream: got %v, want PermissionDenied", err)
+		}
+
+		_, err = logs.GetLogMetadata(otherCtx, &brahmav1.GetLogMetadataRequest{LogId: crashID})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("another device's log: got %v, want PermissionDenied", err)
+		}
+		_, err = logs.ListLogs(otherCtx, &brahmav1.ListLogsRequest{})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("listing every device's logs: got %v, want PermissionDenied", err)
+		}
+
+		_, err = devices.Register(otherCtx, &brahmav1.RegisterRequest{ForeignKey: "switch-01"})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("re-registering another device: got %v, want PermissionDenied", err)
+		}
+
+		_, err = admin.RotateDeviceToken(deviceCtx, &brahmav1.RotateDeviceTokenRequest{Uid: uid})
+		if status.Code(err) != codes.PermissionDenied {
+			t.Errorf("admin RPC with device token: got %v, want PermissionDenied", err)
+		}
+
+		rotated, err := admin.RotateDeviceToken(adminCtx, &brahmav1.RotateDeviceTokenRequest{Uid: uid})
+		if err != nil {
+			t.Fatalf("RotateDeviceToken: %v", err)
+		}
+		if _, err := devices.Heartbeat(deviceCtx, &brahmav1.HeartbeatRequest{Uid: uid}); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("rotated-out token: got %v, want Unauthenticated", err)
+		}
+		deviceCtx = withToken(ctx, rotated.Token)
+		if _, err := devices.Heartbeat(deviceCtx, &brahmav1.HeartbeatRequest{Uid: uid}); err != nil {
+			t.Errorf("Heartbeat with rotated token: %v", err)
+		}
+
+		if _, err := admin.RevokeDeviceToken(adminCtx, &brahmav1.RevokeDeviceTokenRequest{Uid: other.Uid}); err != nil {
+			t.Fatalf("RevokeDeviceToken: %v", err)
+		}
+		if _, err := devices.Heartbeat(otherCtx, &brahmav1.HeartbeatRequest{Uid: other.Uid}); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("revoked token: got %v, want Unauthenticated", err)
+		}
+		if _, err := devices.Register(otherCtx, &brahmav1.RegisterRequest{ForeignKey: "switch-06"}); status.Code(err) != codes.PermissionDenied {
+			t.Errorf("re-registering a revoked device: got %v, want PermissionDenied", err)
+		}
+
+		_, err = admin.RevokeDeviceToken(adminCtx, &brahmav1.RevokeDeviceTokenRequest{Uid: "missing"})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("revoking unknown device: got %v, want NotFound", err)
+		}
+	})
+
+	t.Run("Unregister", func(t *testing.T) {
+		resp, err := devices.Unregister(deviceCtx, &brahmav1.UnregisterRequest{Uid: uid})
+		if err != nil {
+			t.Fatalf("Unregister: %v", err)
+		}
+		if resp.Status != "unregistered" {
+			t.Errorf("Unregister returned %+v", resp)
+		}
+
+		_, err = devices.GetDevice(ctx, &brahmav1.GetDeviceRequest{Uid: uid})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("GetDevice after Unregister: got %v, want NotFound", err)
+		}
+
+		if n := testutil.CollectAndCount(env.exporter); n != 0 {
+			t.Errorf("%d device metric series left after Unregister", n)
+		}
+	})
+
+	env.collector.Stop()
+
+	types := env.splunk.eventTypes()
+	for _, eventType := range []string{
+		"cpu_stats", "process_stats", "mgmt_network_stats", "router_base_state",
+		"interface_stats", "bgp_neighbors", "vxlan_tunnels", "qos_queues",
+	} {
+		if types[eventType] == 0 {
+			t.Errorf("no %s event delivered to Splunk", eventType)
+		}
+	}
+
+	data, err := os.ReadFile(env.sinkPath)
+	if err != nil {
+		t.Fatalf("reading file sink: %v", err)
+	}
+	var fileEvents int
+	for _, line := range bytes.Split(bytes.TrimSpace(data), []byte("\n")) {
+		var event sink.Event
+		if err := json.Unmarshal(line, &event); err != nil {
+			t.Fatalf("file sink line %q: %v", line, err)
+		}
+		if event.Type != "cpu_stats" {
+			t.Errorf("file sink got a %s event, want only cpu_stats", event.Type)
+		}
+		fileEvents++
+	}
+	if fileEvents != types["cpu_stats"] {
+		t.Errorf("file sink has %d cpu_stats events, Splunk has %d", fileEvents, types["cpu_stats"])
+	}
+
+	if n := testutil.ToFloat64(telemetry.RPCRequests.WithLabelValues(brahmav1.MetricsService_ReportCPUStats_FullMethodName, "OK")); n == 0 {
+		t.Error("ReportCPUStats not counted in brahma_grpc_requests_total")
+	}
+	if n := testutil.ToFloat64(telemetry.S3UploadBytes); n < float64(len(crashContent)) {
+		t.Errorf("brahma_s3_upload_bytes_total = %v, want at least %d", n, len(crashContent))
+	}
+	if n := testutil.ToFloat64(telemetry.ActiveUploads.WithLabelValues("crash")); n != 0 {
+		t.Errorf("brahma_active_uploads{log_type=\"crash\"} = %v after uploads finished", n)
+	}
+
+	if event := env.splunk.find("request_id", "req-0001"); event == nil || event["event_type"] != "process_stats" {
+		t.Errorf("request ID not attached to the process_stats event, found %v", event)
+	}
+}
+
+func TestPanicRecovery(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/brahma.v1.DeviceService/Heartbeat"}
+	_, err := unaryRecoveryInterceptor(zap.NewNop())(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal {
+		t.Errorf("panicking handler: got %v, want Internal", err)
+	}
+}
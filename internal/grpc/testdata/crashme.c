@@ -0,0 +1,17 @@
+/* Symbol file fixture for the symbolization tests, which build it with:
+ *   gcc -g -O0 -no-pie -Wl,--build-id -fdebug-prefix-map=$PWD=. -o crashme crashme.c
+ */
+#include <stdlib.h>
+
+void do_task(int n)
+{
+	if (n > 2)
+		abort();
+	do_task(n + 1);
+}
+
+int main(void)
+{
+	do_task(0);
+	return 0;
+}
@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func loadTLSCredentials(cfg config.GRPCConfig) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if cfg.ClientCAFile != "" {
+		pem, err := os.ReadFile(cfg.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in client CA file %s", cfg.ClientCAFile)
+		}
+
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// peerCertificate returns the verified client certificate of the caller, if
+// the connection was authenticated with mutual TLS.
+func peerCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.AuthInfo == nil {
+		return nil, false
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, false
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0], true
+}
+
+// certIdentifiesDevice reports whether cert was issued to the device with the
+// given foreign key, either as its subject common name or as a DNS SAN.
+func certIdentifiesDevice(cert *x509.Certificate, foreignKey string) bool {
+	if cert.Subject.CommonName == foreignKey {
+		return true
+	}
+	for _, name := range cert.DNSNames {
+		if name == foreignKey {
+			return true
+		}
+	}
+	return false
+}
+
+// authorizeForeignKey checks that the caller's client certificate belongs to
+// foreignKey. It always succeeds when mutual TLS is not configured.
+func (s *Server) authorizeForeignKey(ctx context.Context, foreignKey string) error {
+	if !s.mtls {
+		return nil
+	}
+
+	cert, ok := peerCertificate(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "client certificate required")
+	}
+
+	if !certIdentifiesDevice(cert, foreignKey) {
+		return status.Errorf(codes.PermissionDenied, "client certificate %q is not valid for device %q", cert.Subject.CommonName, foreignKey)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+// Package health periodically checks the services Brahma depends on, so the
+// servers can report readiness without probing them on every request.
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/metrics"
+	"github.com/vtapaskar/brahma/internal/splunk"
+	"github.com/vtapaskar/brahma/internal/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	CheckSplunk = "splunk"
+	CheckS3     = "s3"
+	CheckQueue  = "queue"
+)
+
+const (
+	defaultInterval        = 10 * time.Second
+	defaultTimeout         = 5 * time.Second
+	defaultQueueSaturation = 0.9
+)
+
+// Checker runs the dependency checks every interval and keeps the latest
+// results. Nothing is healthy until the first round has completed.
+type Checker struct {
+	splunkClient *splunk.Client
+	s3Client     *storage.S3Client
+	collector    *metrics.Collector
+	interval     time.Duration
+	timeout      time.Duration
+	saturation   float64
+	logger       *zap.Logger
+
+	mu        sync.RWMutex
+	results   map[string]error
+	listeners []func()
+
+	stopChan chan struct{}
+	done     chan struct{}
+}
+
+func NewChecker(cfg config.HealthConfig, splunkClient *splunk.Client, s3Client *storage.S3Client, collector *metrics.Collector, logger *zap.Logger) *Checker {
+	c := &Checker{
+		splunkClient: splunkClient,
+		s3Client:     s3Client,
+		collector:    collector,
+		interval:     time.Duration(cfg.IntervalSeconds) * time.Second,
+		timeout:      time.Duration(cfg.TimeoutSeconds) * time.Second,
+		saturation:   cfg.QueueSaturation,
+		logger:       logger,
+		stopChan:     make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+
+	if c.interval <= 0 {
+		c.interval = defaultInterval
+	}
+	if c.timeout <= 0 {
+		c.timeout = defaultTimeout
+	}
+	if c.saturation <= 0 {
+		c.saturation = defaultQueueSaturation
+	}
+
+	return c
+}
+
+// OnUpdate registers fn to be called after every round of checks.
+func (c *Checker) OnUpdate(fn func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, fn)
+}
+
+func (c *Checker) Run() {
+	defer close(c.done)
+
+	c.Check(context.Background())
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.Check(context.Background())
+		case <-c.stopChan:
+			return
+		}
+	}
+}
+
+func (c *Checker) Stop() {
+	close(c.stopChan)
+	<-c.done
+}
+
+// Check runs every dependency check once and notifies the listeners. The
+// Splunk check is skipped when no events are routed to Splunk.
+func (c *Checker) Check(ctx context.Context) {
+	results := map[string]error{
+		CheckS3:    c.probe(ctx, c.s3Client.CheckBucket),
+		CheckQueue: c.checkQueue(),
+	}
+	if c.splunkClient != nil {
+		results[CheckSplunk] = c.probe(ctx, c.splunkClient.Health)
+	}
+
+	c.mu.Lock()
+	previous := c.results
+	c.results = results
+	listeners := append([]func(){}, c.listeners...)
+	c.mu.Unlock()
+
+	for name, err := range results {
+		prevErr, checked := previous[name]
+		switch {
+		case err != nil && (!checked || prevErr == nil):
+			c.logger.Warn("Health check failing", zap.String("check", name), zap.Error(err))
+		case err == nil && checked && prevErr != nil:
+			c.logger.Info("Health check recovered", zap.String("check", name))
+		}
+	}
+
+	for _, fn := range listeners {
+		fn()
+	}
+}
+
+func (c *Checker) probe(ctx context.Context, check func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	return check(ctx)
+}
+
+func (c *Checker) checkQueue() error {
+	depth, capacity := c.collector.QueueDepth(), c.collector.QueueCapacity()
+	if float64(depth) >= c.saturation*float64(capacity) {
+		return fmt.Errorf("metrics queue is saturated: %d of %d", depth, capacity)
+	}
+	return nil
+}
+
+// Healthy reports whether the named checks passed in the latest round.
+func (c *Checker) Healthy(checks ...string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.results == nil {
+		return false
+	}
+	for _, name := range checks {
+		if c.results[name] != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Results returns "ok" or the failure of every check in the latest round.
+func (c *Checker) Results() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	results := make(map[string]string, len(c.results))
+	for name, err := range c.results {
+		if err != nil {
+			results[name] = err.Error()
+		} else {
+			results[name] = "ok"
+		}
+	}
+	return results
+}
@@ -1,14 +1,24 @@
 package metrics
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"sync"
 	"time"
 
-	"github.com/google/uuid"
 	"github.com/vtapaskar/brahma/internal/config"
-	"github.com/vtapaskar/brahma/internal/splunk"
+	"github.com/vtapaskar/brahma/internal/crash"
+	"github.com/vtapaskar/brahma/internal/requestid"
+	"github.com/vtapaskar/brahma/internal/sink"
 	"github.com/vtapaskar/brahma/internal/storage"
+	"github.com/vtapaskar/brahma/internal/symbols"
+	"github.com/vtapaskar/brahma/internal/techsupport"
+	"github.com/vtapaskar/brahma/internal/telemetry"
 	"go.uber.org/zap"
 )
 
@@ -19,175 +29,316 @@ type BaseMetric struct {
 }
 
 type LogReport struct {
-	ID          string    `json:"id"`
-	DeviceUID   string    `json:"device_uid"`
-	Timestamp   time.Time `json:"timestamp"`
-	LogType     string    `json:"log_type"`
-	ProcessTag  string    `json:"process_tag"`
-	Version     string    `json:"version"`
-	Content     []byte    `json:"-"`
-	Filename    string    `json:"filename"`
-	S3Key       string    `json:"s3_key"`
-}
-
-type LogMetadata struct {
-	LogID      string    `json:"log_id"`
+	ID         string    `json:"id"`
 	DeviceUID  string    `json:"device_uid"`
+	Timestamp  time.Time `json:"timestamp"`
 	LogType    string    `json:"log_type"`
 	ProcessTag string    `json:"process_tag"`
 	Version    string    `json:"version"`
 	Filename   string    `json:"filename"`
 	S3Key      string    `json:"s3_key"`
-	Timestamp  time.Time `json:"timestamp"`
+	// ExpectedSize and ExpectedSHA256 are what the device declared for the
+	// content; zero values are not checked.
+	ExpectedSize   int64  `json:"expected_size,omitempty"`
+	ExpectedSHA256 string `json:"expected_sha256,omitempty"`
+}
+
+// Validate checks the declared size and digest.
+func (r *LogReport) Validate() error {
+	if r.ExpectedSize < 0 {
+		return errors.New("size must not be negative")
+	}
+	if r.ExpectedSize > storage.MaxObjectSize {
+		return storage.ErrObjectTooLarge
+	}
+	if r.ExpectedSHA256 != "" {
+		if b, err := hex.DecodeString(r.ExpectedSHA256); err != nil || len(b) != sha256.Size {
+			return fmt.Errorf("sha256 must be %d hex characters", 2*sha256.Size)
+		}
+	}
+	return nil
+}
+
+type LogMetadata struct {
+	LogID         string    `json:"log_id"`
+	DeviceUID     string    `json:"device_uid"`
+	LogType       string    `json:"log_type"`
+	ProcessTag    string    `json:"process_tag"`
+	Version       string    `json:"version"`
+	Filename      string    `json:"filename"`
+	S3Key         string    `json:"s3_key"`
+	Size          int64     `json:"size"`
+	SHA256        string    `json:"sha256,omitempty"`
+	Signature     string    `json:"signature,omitempty"`
+	SymbolizedKey string    `json:"symbolized_s3_key,omitempty"`
+	Format        string    `json:"format,omitempty"`
+	Signal        string    `json:"signal,omitempty"`
+	Thread        string    `json:"thread,omitempty"`
+	Exception     string    `json:"exception,omitempty"`
+	Taint         string    `json:"taint,omitempty"`
+	TopFrame      string    `json:"top_frame,omitempty"`
+	Frames        []string  `json:"frames,omitempty"`
+	ManifestKey   string    `json:"manifest_s3_key,omitempty"`
+	MemberCount   int       `json:"member_count,omitempty"`
+	IndexError    string    `json:"index_error,omitempty"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// maxMetadataFrames bounds how many parsed frames are kept in log metadata.
+const maxMetadataFrames = 10
+
+// setAnalysis copies what a crash parser extracted from the log.
+func (m *LogMetadata) setAnalysis(analysis *crash.Analysis) {
+	m.Format = analysis.Format
+	m.Signal = analysis.Signal
+	m.Thread = analysis.Thread
+	m.Exception = analysis.Exception
+	m.Taint = analysis.Taint
+	m.TopFrame = analysis.TopFrame()
+	m.Frames = nil
+	for _, frame := range analysis.Frames[:min(len(analysis.Frames), maxMetadataFrames)] {
+		function := frame.Function
+		if !frame.Symbolized() {
+			function = "??"
+		}
+		m.Frames = append(m.Frames, function)
+	}
 }
 
 type Collector struct {
-	config       config.MetricsConfig
-	splunkClient *splunk.Client
-	s3Client     *storage.S3Client
-	logger       *zap.Logger
-	metricBuffer []interface{}
-	bufferMu     sync.Mutex
-	stopChan     chan struct{}
+	config   config.MetricsConfig
+	sink     sink.Sink
+	s3Client *storage.S3Client
+	logIndex *LogIndex
+	crashes  *crash.Index
+	parsers  *crash.Registry
+	symbols  *symbols.Store
+	exporter *DeviceExporter
+	logger   *zap.Logger
+	queue    chan queuedMetric
+	queueMu  sync.RWMutex
+	stopped  bool
+	workers  sync.WaitGroup
+
+	techsupportLimits techsupport.Limits
+	indexing          sync.WaitGroup
+	indexSlots        chan struct{}
+	indexCtx          context.Context
+	stopIndexing      context.CancelFunc
 }
 
-func NewCollector(cfg config.MetricsConfig, splunkClient *splunk.Client, s3Client *storage.S3Client, logger *zap.Logger) *Collector {
+// queuedMetric is a metric waiting for delivery, with the ID of the request
+// that reported it.
+type queuedMetric struct {
+	metricType string
+	data       interface{}
+	requestID  string
+}
+
+// ErrSymbolsDisabled is returned by StoreSymbols when no symbol store is
+// configured.
+var ErrSymbolsDisabled = errors.New("symbol store is not configured")
+
+// ErrIntegrity is returned by LogUpload.Commit when the content does not match
+// the size or SHA-256 the device declared.
+var ErrIntegrity = errors.New("upload failed integrity check")
+
+// ErrQueueFull is returned by the Collect methods when the ingestion queue is
+// full; callers should ask the device to retry later.
+var ErrQueueFull = errors.New("metrics queue is full")
+
+const (
+	defaultBufferSize    = 100
+	defaultFlushInterval = 30
+	defaultQueueSize     = 10000
+	defaultWorkers       = 4
+)
+
+func NewCollector(cfg config.MetricsConfig, eventSink sink.Sink, s3Client *storage.S3Client, logIndex *LogIndex, crashes *crash.Index, symbolStore *symbols.Store, exporter *DeviceExporter, logger *zap.Logger) *Collector {
+	if cfg.BufferSize <= 0 {
+		cfg.BufferSize = defaultBufferSize
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = defaultFlushInterval
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultQueueSize
+	}
+	if cfg.Workers <= 0 {
+		cfg.Workers = defaultWorkers
+	}
+
 	c := &Collector{
-		config:       cfg,
-		splunkClient: splunkClient,
-		s3Client:     s3Client,
-		logger:       logger,
-		metricBuffer: make([]interface{}, 0, cfg.BufferSize),
-		stopChan:     make(chan struct{}),
+		config:   cfg,
+		sink:     eventSink,
+		s3Client: s3Client,
+		logIndex: logIndex,
+		crashes:  crashes,
+		parsers:  crash.DefaultRegistry(),
+		symbols:  symbolStore,
+		exporter: exporter,
+		logger:   logger,
+		queue:    make(chan queuedMetric, cfg.QueueSize),
+		techsupportLimits: techsupport.Limits{
+			MaxBytes:       cfg.TechSupportMaxBytes,
+			MaxMemberBytes: cfg.TechSupportMaxMemberBytes,
+		},
+		indexSlots: make(chan struct{}, maxConcurrentIndexing),
 	}
+	c.indexCtx, c.stopIndexing = context.WithCancel(context.Background())
 
-	go c.flushLoop()
+	for i := 0; i < cfg.Workers; i++ {
+		c.workers.Add(1)
+		go c.worker()
+	}
+	c.resumeIndexing()
 
 	return c
 }
 
-func (c *Collector) CollectCPUStats(stats *CPUStats) error {
+func (c *Collector) CollectCPUStats(ctx context.Context, stats *CPUStats) error {
 	stats.Timestamp = time.Now()
-	return c.bufferMetric("cpu_stats", stats.UID, stats)
+	if err := c.bufferMetric(ctx, "cpu_stats", stats.UID, stats); err != nil {
+		return err
+	}
+	// Only metrics that were accepted show up on /metrics/devices.
+	if c.exporter != nil {
+		c.exporter.setCPUStats(stats)
+	}
+	return nil
 }
 
-func (c *Collector) CollectProcessStats(stats *ProcessStats) error {
+func (c *Collector) CollectProcessStats(ctx context.Context, stats *ProcessStats) error {
 	stats.Timestamp = time.Now()
-	return c.bufferMetric("process_stats", stats.UID, stats)
+	return c.bufferMetric(ctx, "process_stats", stats.UID, stats)
 }
 
-func (c *Collector) CollectMgmtNetworkStats(stats *MgmtNetworkStats) error {
+func (c *Collector) CollectMgmtNetworkStats(ctx context.Context, stats *MgmtNetworkStats) error {
 	stats.Timestamp = time.Now()
-	return c.bufferMetric("mgmt_network_stats", stats.UID, stats)
+	if err := c.bufferMetric(ctx, "mgmt_network_stats", stats.UID, stats); err != nil {
+		return err
+	}
+	if c.exporter != nil {
+		c.exporter.setMgmtNetworkStats(stats)
+	}
+	return nil
 }
 
-func (c *Collector) CollectRouterBaseState(state *RouterBaseState) error {
+func (c *Collector) CollectRouterBaseState(ctx context.Context, state *RouterBaseState) error {
 	state.Timestamp = time.Now()
-	return c.bufferMetric("router_base_state", state.UID, state)
+	if err := c.bufferMetric(ctx, "router_base_state", state.UID, state); err != nil {
+		return err
+	}
+	if c.exporter != nil {
+		c.exporter.setRouterBaseState(state)
+	}
+	return nil
 }
 
-func (c *Collector) bufferMetric(metricType string, uid string, data interface{}) error {
-	c.bufferMu.Lock()
-	defer c.bufferMu.Unlock()
+func (c *Collector) CollectInterfaceStats(ctx context.Context, stats *InterfaceStats) error {
+	stats.Timestamp = time.Now()
+	return c.bufferMetric(ctx, "interface_stats", stats.UID, stats)
+}
 
-	c.metricBuffer = append(c.metricBuffer, data)
+func (c *Collector) CollectBGPNeighborStats(ctx context.Context, stats *BGPNeighborStats) error {
+	stats.Timestamp = time.Now()
+	return c.bufferMetric(ctx, "bgp_neighbors", stats.UID, stats)
+}
 
-	if len(c.metricBuffer) >= c.config.BufferSize {
-		return c.flush()
-	}
+func (c *Collector) CollectVXLANTunnelStats(ctx context.Context, stats *VXLANTunnelStats) error {
+	stats.Timestamp = time.Now()
+	return c.bufferMetric(ctx, "vxlan_tunnels", stats.UID, stats)
+}
 
-	return nil
+func (c *Collector) CollectQoSQueueStats(ctx context.Context, stats *QoSQueueStats) error {
+	stats.Timestamp = time.Now()
+	return c.bufferMetric(ctx, "qos_queues", stats.UID, stats)
 }
 
-func (c *Collector) CollectCrashReport(report *LogReport) (string, error) {
-	report.ID = uuid.New().String()
-	report.Timestamp = time.Now()
-	report.LogType = "crash"
+// bufferMetric only enqueues; delivery to Splunk happens on the worker
+// goroutines so RPC handlers never wait on HEC.
+func (c *Collector) bufferMetric(ctx context.Context, metricType string, uid string, data interface{}) error {
+	c.queueMu.RLock()
+	defer c.queueMu.RUnlock()
 
-	s3Key := c.s3Client.GenerateLogKey(report.DeviceUID, report.ID, "crash")
-	report.S3Key = s3Key
+	if c.stopped {
+		return errors.New("collector is stopped")
+	}
 
-	if err := c.s3Client.Upload(s3Key, report.Content); err != nil {
-		c.logger.Error("Failed to upload crash report to S3",
-			zap.String("device_uid", report.DeviceUID),
-			zap.String("log_id", report.ID),
-			zap.Error(err),
+	select {
+	case c.queue <- queuedMetric{metricType: metricType, data: data, requestID: requestid.FromContext(ctx)}:
+		telemetry.CollectorQueueDepth.Set(float64(len(c.queue)))
+		return nil
+	default:
+		c.logger.Warn("Metrics queue full, rejecting metric",
+			zap.String("uid", uid),
+			zap.String("metric_type", metricType),
 		)
-		return "", err
+		return ErrQueueFull
 	}
+}
 
-	metadata := LogMetadata{
-		LogID:      report.ID,
-		DeviceUID:  report.DeviceUID,
-		LogType:    report.LogType,
-		ProcessTag: report.ProcessTag,
-		Version:    report.Version,
-		Filename:   report.Filename,
-		S3Key:      s3Key,
-		Timestamp:  report.Timestamp,
-	}
+// QueueDepth returns the number of metrics waiting for a worker.
+func (c *Collector) QueueDepth() int {
+	return len(c.queue)
+}
 
-	if err := c.sendLogMetadata(&metadata); err != nil {
-		c.logger.Warn("Failed to send crash report metadata to Splunk",
-			zap.String("log_id", report.ID),
-			zap.Error(err),
-		)
-	}
+// QueueCapacity returns the size of the ingestion queue.
+func (c *Collector) QueueCapacity() int {
+	return cap(c.queue)
+}
 
-	c.logger.Info("Crash report stored",
-		zap.String("log_id", report.ID),
-		zap.String("device_uid", report.DeviceUID),
-		zap.String("s3_key", s3Key),
-	)
+// RetryDelay is how long a device should wait before resending after
+// ErrQueueFull: roughly the time it takes the workers to drain a flush.
+func (c *Collector) RetryDelay() time.Duration {
+	return time.Duration(c.config.FlushInterval) * time.Second / 2
+}
+
+func (c *Collector) GetLogMetadata(logID string) (*LogMetadata, bool) {
+	return c.logIndex.Get(logID)
+}
 
-	return report.ID, nil
+func (c *Collector) ListLogs(q LogQuery) ([]*LogMetadata, int) {
+	return c.logIndex.List(q)
 }
 
-func (c *Collector) CollectBacktrace(report *LogReport) (string, error) {
-	report.ID = uuid.New().String()
-	report.Timestamp = time.Now()
-	report.LogType = "backtrace"
+func (c *Collector) GetCrashBucket(signature string) (*crash.Bucket, bool) {
+	return c.crashes.Get(signature)
+}
 
-	s3Key := c.s3Client.GenerateLogKey(report.DeviceUID, report.ID, "backtrace")
-	report.S3Key = s3Key
+func (c *Collector) ListCrashBuckets(q crash.BucketQuery) ([]*crash.Bucket, int) {
+	return c.crashes.List(q)
+}
 
-	if err := c.s3Client.Upload(s3Key, report.Content); err != nil {
-		c.logger.Error("Failed to upload backtrace to S3",
-			zap.String("device_uid", report.DeviceUID),
-			zap.String("log_id", report.ID),
-			zap.Error(err),
-		)
-		return "", err
+// StoreSymbols adds a debug symbol bundle for processTag in version.
+func (c *Collector) StoreSymbols(version, processTag, filename string, r io.Reader) ([]string, error) {
+	if c.symbols == nil {
+		return nil, ErrSymbolsDisabled
 	}
+	return c.symbols.Put(version, processTag, filename, r)
+}
 
-	metadata := LogMetadata{
-		LogID:      report.ID,
-		DeviceUID:  report.DeviceUID,
-		LogType:    report.LogType,
-		ProcessTag: report.ProcessTag,
-		Version:    report.Version,
-		Filename:   report.Filename,
-		S3Key:      s3Key,
-		Timestamp:  report.Timestamp,
+// OpenLog streams a stored log, or its symbolized copy when symbolized is set
+// and the log has one.
+func (c *Collector) OpenLog(ctx context.Context, metadata *LogMetadata, symbolized bool) (io.ReadCloser, error) {
+	key := metadata.S3Key
+	if symbolized {
+		key = metadata.SymbolizedKey
 	}
 
-	if err := c.sendLogMetadata(&metadata); err != nil {
-		c.logger.Warn("Failed to send backtrace metadata to Splunk",
-			zap.String("log_id", report.ID),
+	body, _, err := c.s3Client.Open(ctx, key)
+	if err != nil {
+		c.logger.Error("Failed to open stored log",
+			zap.String("log_id", metadata.LogID),
+			zap.String("s3_key", key),
 			zap.Error(err),
 		)
+		return nil, err
 	}
-
-	c.logger.Info("Backtrace stored",
-		zap.String("log_id", report.ID),
-		zap.String("device_uid", report.DeviceUID),
-		zap.String("s3_key", s3Key),
-	)
-
-	return report.ID, nil
+	return body, nil
 }
 
-func (c *Collector) sendLogMetadata(metadata *LogMetadata) error {
+func (c *Collector) sendLogMetadata(metadata *LogMetadata, requestID string) error {
 	eventData := map[string]interface{}{
 		"log_id":      metadata.LogID,
 		"device_uid":  metadata.DeviceUID,
@@ -196,39 +347,100 @@ func (c *Collector) sendLogMetadata(metadata *LogMetadata) error {
 		"version":     metadata.Version,
 		"filename":    metadata.Filename,
 		"s3_key":      metadata.S3Key,
+		"size":        metadata.Size,
 		"timestamp":   metadata.Timestamp,
 	}
+	if metadata.SHA256 != "" {
+		eventData["sha256"] = metadata.SHA256
+	}
+	if metadata.Signature != "" {
+		eventData["signature"] = metadata.Signature
+	}
+	if metadata.SymbolizedKey != "" {
+		eventData["symbolized_s3_key"] = metadata.SymbolizedKey
+	}
+	if metadata.Format != "" {
+		eventData["format"] = metadata.Format
+		for key, value := range map[string]string{
+			"signal":    metadata.Signal,
+			"thread":    metadata.Thread,
+			"exception": metadata.Exception,
+			"taint":     metadata.Taint,
+			"top_frame": metadata.TopFrame,
+		} {
+			if value != "" {
+				eventData[key] = value
+			}
+		}
+		if len(metadata.Frames) > 0 {
+			eventData["frames"] = metadata.Frames
+		}
+	}
+	if metadata.ManifestKey != "" {
+		eventData["manifest_s3_key"] = metadata.ManifestKey
+		eventData["member_count"] = metadata.MemberCount
+	}
+	if metadata.IndexError != "" {
+		eventData["index_error"] = metadata.IndexError
+	}
+	if requestID != "" {
+		eventData["request_id"] = requestID
+	}
 
-	return c.splunkClient.SendEvent("log_metadata", eventData)
+	return c.sink.Write([]sink.Event{sink.NewEvent("log_metadata", eventData)})
 }
 
-func (c *Collector) flushLoop() {
+func (c *Collector) worker() {
+	defer c.workers.Done()
+
 	ticker := time.NewTicker(time.Duration(c.config.FlushInterval) * time.Second)
 	defer ticker.Stop()
 
+	batch := make([]queuedMetric, 0, c.config.BufferSize)
+
 	for {
 		select {
-		case <-ticker.C:
-			c.bufferMu.Lock()
-			if len(c.metricBuffer) > 0 {
-				if err := c.flush(); err != nil {
+		case metric, ok := <-c.queue:
+			if !ok {
+				if err := c.flush(batch); err != nil {
 					c.logger.Error("Failed to flush metrics", zap.Error(err))
 				}
+				return
 			}
-			c.bufferMu.Unlock()
-		case <-c.stopChan:
-			return
+
+			telemetry.CollectorQueueDepth.Set(float64(len(c.queue)))
+			telemetry.CollectorBuffered.Inc()
+			batch = append(batch, metric)
+			if len(batch) < c.config.BufferSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		if err := c.flush(batch); err != nil {
+			c.logger.Error("Failed to flush metrics", zap.Error(err))
 		}
+		batch = batch[:0]
 	}
 }
 
-func (c *Collector) flush() error {
-	if len(c.metricBuffer) == 0 {
+func (c *Collector) flush(batch []queuedMetric) error {
+	if len(batch) == 0 {
 		return nil
 	}
 
-	for _, metric := range c.metricBuffer {
-		data, err := json.Marshal(metric)
+	start := time.Now()
+	defer func() {
+		telemetry.CollectorFlushDuration.Observe(telemetry.Since(start))
+		telemetry.CollectorBuffered.Sub(float64(len(batch)))
+	}()
+
+	events := make([]sink.Event, 0, len(batch))
+	for _, metric := range batch {
+		data, err := json.Marshal(metric.data)
 		if err != nil {
 			c.logger.Error("Failed to marshal metric", zap.Error(err))
 			continue
@@ -236,51 +448,36 @@ func (c *Collector) flush() error {
 
 		var eventData map[string]interface{}
 		json.Unmarshal(data, &eventData)
-
-		metricType := c.getMetricType(metric)
-		uid := c.getUID(eventData)
-
-		if err := c.splunkClient.SendEvent(metricType, eventData); err != nil {
-			c.logger.Error("Failed to send metric to Splunk",
-				zap.String("uid", uid),
-				zap.String("metric_type", metricType),
-				zap.Error(err),
-			)
+		if metric.requestID != "" {
+			eventData["request_id"] = metric.requestID
 		}
+
+		events = append(events, sink.NewEvent(metric.metricType, eventData))
 	}
 
-	c.logger.Info("Flushed metrics to Splunk", zap.Int("count", len(c.metricBuffer)))
-	c.metricBuffer = c.metricBuffer[:0]
+	if err := c.sink.Write(events); err != nil {
+		return err
+	}
 
+	c.logger.Info("Flushed metrics", zap.Int("count", len(events)))
 	return nil
 }
 
-func (c *Collector) getMetricType(metric interface{}) string {
-	switch metric.(type) {
-	case *CPUStats:
-		return "cpu_stats"
-	case *ProcessStats:
-		return "process_stats"
-	case *MgmtNetworkStats:
-		return "mgmt_network_stats"
-	case *RouterBaseState:
-		return "router_base_state"
-	default:
-		return "unknown"
-	}
-}
-
-func (c *Collector) getUID(data map[string]interface{}) string {
-	if uid, ok := data["uid"].(string); ok {
-		return uid
+// Stop rejects new metrics, then waits for the workers to drain the queue and
+// flush what they hold. Archives still being indexed are indexed again on
+// the next start.
+func (c *Collector) Stop() {
+	c.queueMu.Lock()
+	if c.stopped {
+		c.queueMu.Unlock()
+		return
 	}
-	return ""
-}
+	c.stopped = true
+	close(c.queue)
+	c.queueMu.Unlock()
 
-func (c *Collector) Stop() {
-	close(c.stopChan)
+	c.workers.Wait()
 
-	c.bufferMu.Lock()
-	defer c.bufferMu.Unlock()
-	c.flush()
+	c.stopIndexing()
+	c.indexing.Wait()
 }
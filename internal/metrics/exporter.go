@@ -0,0 +1,210 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/registry"
+)
+
+var defaultDeviceLabels = []string{"site"}
+
+// DeviceExporter keeps the latest CPU, management network and base state
+// reported by each device and exposes them to Prometheus, labelled with the
+// device's registry details. Only online devices are exported: the metrics of
+// a device that has gone stale or been unregistered are dropped at the next
+// scrape.
+type DeviceExporter struct {
+	registry  *registry.Registry
+	labelKeys []string
+	gatherer  *prometheus.Registry
+
+	mu      sync.Mutex
+	devices map[string]*deviceMetrics
+
+	cpuUsage    *prometheus.Desc
+	cpuIOWait   *prometheus.Desc
+	cpuCores    *prometheus.Desc
+	loadAverage *prometheus.Desc
+	mgmtUp      *prometheus.Desc
+	mgmtBytes   *prometheus.Desc
+	mgmtPackets *prometheus.Desc
+	mgmtErrors  *prometheus.Desc
+	mgmtDropped *prometheus.Desc
+	uptime      *prometheus.Desc
+	memoryTotal *prometheus.Desc
+	memoryUsed  *prometheus.Desc
+	diskTotal   *prometheus.Desc
+	diskUsed    *prometheus.Desc
+	info        *prometheus.Desc
+}
+
+type deviceMetrics struct {
+	cpu  *CPUStats
+	mgmt *MgmtNetworkStats
+	base *RouterBaseState
+}
+
+// NewDeviceExporter labels every series with the device's uid, hostname and
+// device_type, plus the registry labels named in cfg.DeviceLabels.
+func NewDeviceExporter(cfg config.MetricsConfig, reg *registry.Registry) (*DeviceExporter, error) {
+	labelKeys := cfg.DeviceLabels
+	if labelKeys == nil {
+		labelKeys = defaultDeviceLabels
+	}
+
+	labels := []string{"uid", "hostname", "device_type"}
+	for _, key := range labelKeys {
+		labels = append(labels, labelName(key))
+	}
+
+	desc := func(name, help string, extra ...string) *prometheus.Desc {
+		return prometheus.NewDesc("brahma_device_"+name, help, append(append([]string{}, labels...), extra...), nil)
+	}
+
+	e := &DeviceExporter{
+		registry:  reg,
+		labelKeys: labelKeys,
+		gatherer:  prometheus.NewRegistry(),
+		devices:   make(map[string]*deviceMetrics),
+
+		cpuUsage:    desc("cpu_usage_percent", "CPU usage reported by the device."),
+		cpuIOWait:   desc("cpu_iowait_percent", "CPU time waiting on I/O reported by the device."),
+		cpuCores:    desc("cpu_cores", "CPU cores of the device."),
+		loadAverage: desc("load_average", "Load average of the device, by period (1m, 5m or 15m).", "period"),
+		mgmtUp:      desc("mgmt_up", "Whether the management interface is up.", "interface"),
+		mgmtBytes:   desc("mgmt_bytes_total", "Bytes on the management interface, by direction.", "interface", "direction"),
+		mgmtPackets: desc("mgmt_packets_total", "Packets on the management interface, by direction.", "interface", "direction"),
+		mgmtErrors:  desc("mgmt_errors_total", "Errors on the management interface, by direction.", "interface", "direction"),
+		mgmtDropped: desc("mgmt_dropped_total", "Dropped packets on the management interface, by direction.", "interface", "direction"),
+		uptime:      desc("uptime_seconds", "Uptime of the device."),
+		memoryTotal: desc("memory_total_bytes", "Memory of the device."),
+		memoryUsed:  desc("memory_used_bytes", "Memory in use on the device."),
+		diskTotal:   desc("disk_total_bytes", "Disk capacity of the device."),
+		diskUsed:    desc("disk_used_bytes", "Disk space in use on the device."),
+		info:        desc("info", "Software and platform of the device; always 1.", "platform", "sonic_version", "software_version", "kernel_version"),
+	}
+
+	if err := e.gatherer.Register(e); err != nil {
+		return nil, fmt.Errorf("failed to register device metrics: %w", err)
+	}
+
+	return e, nil
+}
+
+// labelName turns a registry label key into a valid Prometheus label name.
+func labelName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, key)
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
+
+// Handler serves the device metrics in the Prometheus exposition format.
+func (e *DeviceExporter) Handler() http.Handler {
+	return promhttp.HandlerFor(e.gatherer, promhttp.HandlerOpts{})
+}
+
+func (e *DeviceExporter) update(uid string, fn func(*deviceMetrics)) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	m, ok := e.devices[uid]
+	if !ok {
+		m = &deviceMetrics{}
+		e.devices[uid] = m
+	}
+	fn(m)
+}
+
+func (e *DeviceExporter) setCPUStats(stats *CPUStats) {
+	e.update(stats.UID, func(m *deviceMetrics) { m.cpu = stats })
+}
+
+func (e *DeviceExporter) setMgmtNetworkStats(stats *MgmtNetworkStats) {
+	e.update(stats.UID, func(m *deviceMetrics) { m.mgmt = stats })
+}
+
+func (e *DeviceExporter) setRouterBaseState(state *RouterBaseState) {
+	e.update(state.UID, func(m *deviceMetrics) { m.base = state })
+}
+
+func (e *DeviceExporter) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{
+		e.cpuUsage, e.cpuIOWait, e.cpuCores, e.loadAverage,
+		e.mgmtUp, e.mgmtBytes, e.mgmtPackets, e.mgmtErrors, e.mgmtDropped,
+		e.uptime, e.memoryTotal, e.memoryUsed, e.diskTotal, e.diskUsed, e.info,
+	} {
+		ch <- desc
+	}
+}
+
+func (e *DeviceExporter) Collect(ch chan<- prometheus.Metric) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for uid, m := range e.devices {
+		device, ok := e.registry.Snapshot(uid)
+		if !ok || device.State != registry.StateOnline {
+			delete(e.devices, uid)
+			continue
+		}
+
+		labels := []string{device.UID, device.Hostname, device.DeviceType}
+		for _, key := range e.labelKeys {
+			labels = append(labels, device.Labels[key])
+		}
+
+		gauge := func(desc *prometheus.Desc, value float64, extra ...string) {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, value, append(append([]string{}, labels...), extra...)...)
+		}
+		counter := func(desc *prometheus.Desc, value uint64, extra ...string) {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(value), append(append([]string{}, labels...), extra...)...)
+		}
+
+		if cpu := m.cpu; cpu != nil {
+			gauge(e.cpuUsage, cpu.UsagePercent)
+			gauge(e.cpuIOWait, cpu.IOWaitPercent)
+			gauge(e.cpuCores, float64(cpu.NumCores))
+			gauge(e.loadAverage, cpu.LoadAvg1Min, "1m")
+			gauge(e.loadAverage, cpu.LoadAvg5Min, "5m")
+			gauge(e.loadAverage, cpu.LoadAvg15Min, "15m")
+		}
+
+		if mgmt := m.mgmt; mgmt != nil {
+			up := 0.0
+			if strings.EqualFold(mgmt.Status, "up") {
+				up = 1
+			}
+			gauge(e.mgmtUp, up, mgmt.InterfaceName)
+			counter(e.mgmtBytes, mgmt.RxBytes, mgmt.InterfaceName, "receive")
+			counter(e.mgmtBytes, mgmt.TxBytes, mgmt.InterfaceName, "transmit")
+			counter(e.mgmtPackets, mgmt.RxPackets, mgmt.InterfaceName, "receive")
+			counter(e.mgmtPackets, mgmt.TxPackets, mgmt.InterfaceName, "transmit")
+			counter(e.mgmtErrors, mgmt.RxErrors, mgmt.InterfaceName, "receive")
+			counter(e.mgmtErrors, mgmt.TxErrors, mgmt.InterfaceName, "transmit")
+			counter(e.mgmtDropped, mgmt.RxDropped, mgmt.InterfaceName, "receive")
+			counter(e.mgmtDropped, mgmt.TxDropped, mgmt.InterfaceName, "transmit")
+		}
+
+		if base := m.base; base != nil {
+			gauge(e.uptime, float64(base.UptimeSeconds))
+			gauge(e.memoryTotal, float64(base.MemoryTotal))
+			gauge(e.memoryUsed, float64(base.MemoryUsed))
+			gauge(e.diskTotal, float64(base.DiskTotal))
+			gauge(e.diskUsed, float64(base.DiskUsed))
+			gauge(e.info, 1, base.Platform, base.SONiCVersion, base.SoftwareVersion, base.KernelVersion)
+		}
+	}
+}
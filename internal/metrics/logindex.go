@@ -0,0 +1,249 @@
+package metrics
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/vtapaskar/brahma/internal/fsutil"
+)
+
+const (
+	defaultLogListLimit = 100
+	maxLogListLimit     = 1000
+
+	// compactAfterStaleLines is how many superseded or unreadable lines the
+	// index file may hold before it is rewritten on load.
+	compactAfterStaleLines = 1000
+)
+
+type LogQuery struct {
+	DeviceUID string
+	LogType   string
+	Limit     int
+	Offset    int
+}
+
+// LogIndex keeps the metadata of every stored crash report and backtrace so
+// they can be looked up again by log ID or listed per device. When a path is
+// configured each entry is also appended to a JSON-lines file that is replayed
+// on startup. Adding an entry again, as indexing a techsupport archive does,
+// appends a line that supersedes the earlier one; the file is rewritten with
+// one line per entry on startup once enough lines are superseded.
+type LogIndex struct {
+	entries map[string]*LogMetadata
+	ordered []*LogMetadata
+	file    *os.File
+	mu      sync.RWMutex
+}
+
+func NewLogIndex(path string) (*LogIndex, error) {
+	idx := &LogIndex{
+		entries: make(map[string]*LogMetadata),
+	}
+
+	if path == "" {
+		return idx, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create log index directory: %w", err)
+	}
+
+	lines, err := idx.load(path)
+	if err != nil {
+		return nil, err
+	}
+	if lines-len(idx.entries) > compactAfterStaleLines {
+		if err := idx.compact(path); err != nil {
+			return nil, err
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open log index: %w", err)
+	}
+	idx.file = file
+
+	return idx, nil
+}
+
+// load replays the index file. Add writes each entry and its newline in one
+// write, so only the last line can be incomplete, after a crash mid-write. It
+// is cut off so the next entry does not get appended onto it. It returns the
+// number of complete lines read.
+func (idx *LogIndex) load(path string) (int, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to open log index: %w", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var end int64
+	lines := 0
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to read log index: %w", err)
+		}
+		end += int64(len(line))
+		lines++
+
+		var entry LogMetadata
+		if err := json.Unmarshal(line, &entry); err != nil {
+			// Blank, or torn by a crash before torn tails were cut off.
+			continue
+		}
+		idx.insert(&entry)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read log index: %w", err)
+	}
+	if info.Size() > end {
+		if err := os.Truncate(path, end); err != nil {
+			return 0, fmt.Errorf("failed to truncate torn log index entry: %w", err)
+		}
+	}
+
+	return lines, nil
+}
+
+// compact replaces the index file at path with one line per entry, oldest
+// first. It must run before the file is opened for appending.
+func (idx *LogIndex) compact(path string) error {
+	var buf bytes.Buffer
+	for i := len(idx.ordered) - 1; i >= 0; i-- {
+		line, err := json.Marshal(idx.ordered[i])
+		if err != nil {
+			return fmt.Errorf("failed to marshal log metadata: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	tmp := path + ".tmp"
+	if err := fsutil.WriteFileSync(tmp, buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write log index: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to install log index: %w", err)
+	}
+	if err := fsutil.SyncDir(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("failed to sync log index directory: %w", err)
+	}
+
+	return nil
+}
+
+func (idx *LogIndex) Add(entry *LogMetadata) error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.file != nil {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to marshal log metadata: %w", err)
+		}
+		if _, err := idx.file.Write(append(line, '\n')); err != nil {
+			return fmt.Errorf("failed to append to log index: %w", err)
+		}
+	}
+
+	idx.insert(entry)
+	return nil
+}
+
+// insert must be called with mu held (or before the index is shared).
+func (idx *LogIndex) insert(entry *LogMetadata) {
+	if _, exists := idx.entries[entry.LogID]; exists {
+		for i, e := range idx.ordered {
+			if e.LogID == entry.LogID {
+				idx.ordered = append(idx.ordered[:i], idx.ordered[i+1:]...)
+				break
+			}
+		}
+	}
+
+	idx.entries[entry.LogID] = entry
+
+	// Keep ordered newest first so listing never has to sort.
+	i := sort.Search(len(idx.ordered), func(i int) bool {
+		return idx.ordered[i].Timestamp.Before(entry.Timestamp)
+	})
+	idx.ordered = append(idx.ordered, nil)
+	copy(idx.ordered[i+1:], idx.ordered[i:])
+	idx.ordered[i] = entry
+}
+
+func (idx *LogIndex) Get(logID string) (*LogMetadata, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	entry, exists := idx.entries[logID]
+	return entry, exists
+}
+
+// List returns the page of entries matching q, newest first, along with the
+// total number of matches before pagination.
+func (idx *LogIndex) List(q LogQuery) ([]*LogMetadata, int) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultLogListLimit
+	}
+	if limit > maxLogListLimit {
+		limit = maxLogListLimit
+	}
+
+	offset := q.Offset
+	if offset < 0 {
+		offset = 0
+	}
+
+	var page []*LogMetadata
+	total := 0
+	for _, entry := range idx.ordered {
+		if q.DeviceUID != "" && entry.DeviceUID != q.DeviceUID {
+			continue
+		}
+		if q.LogType != "" && entry.LogType != q.LogType {
+			continue
+		}
+		if total >= offset && len(page) < limit {
+			page = append(page, entry)
+		}
+		total++
+	}
+
+	return page, total
+}
+
+func (idx *LogIndex) Close() error {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	if idx.file == nil {
+		return nil
+	}
+	err := idx.file.Close()
+	idx.file = nil
+	return err
+}
@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLogIndexTornTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+
+	idx, err := NewLogIndex(path)
+	if err != nil {
+		t.Fatalf("NewLogIndex: %v", err)
+	}
+	if err := idx.Add(&LogMetadata{LogID: "first", DeviceUID: "dev", Timestamp: time.Now()}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	idx.Close()
+
+	// Simulate a crash in the middle of writing the next entry.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"log_id":"torn","device_ui`)
+	f.Close()
+
+	idx, err = NewLogIndex(path)
+	if err != nil {
+		t.Fatalf("NewLogIndex after torn write: %v", err)
+	}
+	if err := idx.Add(&LogMetadata{LogID: "second", DeviceUID: "dev", Timestamp: time.Now()}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	idx.Close()
+
+	idx, err = NewLogIndex(path)
+	if err != nil {
+		t.Fatalf("NewLogIndex: %v", err)
+	}
+	defer idx.Close()
+	for _, id := range []string{"first", "second"} {
+		if _, ok := idx.Get(id); !ok {
+			t.Errorf("entry %s lost after reload", id)
+		}
+	}
+	if _, ok := idx.Get("torn"); ok {
+		t.Error("torn entry was loaded")
+	}
+}
+
+func TestLogIndexCompactsOnLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+	start := time.Now()
+
+	idx, err := NewLogIndex(path)
+	if err != nil {
+		t.Fatalf("NewLogIndex: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		idx.Add(&LogMetadata{LogID: fmt.Sprintf("log%d", i), DeviceUID: "dev", Timestamp: start.Add(time.Duration(i) * time.Second)})
+	}
+	// Re-adding an entry, as techsupport indexing does, supersedes it.
+	for i := 0; i <= compactAfterStaleLines; i++ {
+		idx.Add(&LogMetadata{LogID: "log1", DeviceUID: "dev", MemberCount: i, Timestamp: start.Add(time.Second)})
+	}
+	idx.Close()
+
+	idx, err = NewLogIndex(path)
+	if err != nil {
+		t.Fatalf("NewLogIndex: %v", err)
+	}
+	defer idx.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 3 {
+		t.Errorf("index file has %d lines after compaction, want 3", lines)
+	}
+	if entry, ok := idx.Get("log1"); !ok || entry.MemberCount != compactAfterStaleLines {
+		t.Errorf("log1 = %+v after compaction, want the latest entry", entry)
+	}
+	page, total := idx.List(LogQuery{})
+	if total != 3 || page[0].LogID != "log2" || page[2].LogID != "log0" {
+		t.Errorf("List after compaction: %d entries, first %s", total, page[0].LogID)
+	}
+
+	// Appends after compaction land on a new line.
+	if err := idx.Add(&LogMetadata{LogID: "log3", DeviceUID: "dev", Timestamp: start.Add(3 * time.Second)}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	idx.Close()
+	idx, err = NewLogIndex(path)
+	if err != nil {
+		t.Fatalf("NewLogIndex: %v", err)
+	}
+	defer idx.Close()
+	if _, total := idx.List(LogQuery{}); total != 4 {
+		t.Errorf("%d entries after reload, want 4", total)
+	}
+}
@@ -0,0 +1,276 @@
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/vtapaskar/brahma/internal/crash"
+	"github.com/vtapaskar/brahma/internal/requestid"
+	"github.com/vtapaskar/brahma/internal/storage"
+	"github.com/vtapaskar/brahma/internal/telemetry"
+	"go.uber.org/zap"
+)
+
+// maxCapture bounds how much of a crash report or backtrace is kept in
+// memory to parse or symbolize it.
+const maxCapture = 1024 * 1024
+
+// LogUpload is an in-progress crash report or backtrace upload. Content is
+// streamed to S3 as it is written; the log only becomes visible through the
+// index once Commit succeeds. The start of a crash report or backtrace is
+// also kept in capture so Commit can symbolize a backtrace, parse the log and
+// assign a crash report to its crash bucket; a techsupport archive is indexed
+// in the background once committed.
+type LogUpload struct {
+	collector *Collector
+	report    *LogReport
+	upload    *storage.Upload
+	capture   *bytes.Buffer
+	digest    hash.Hash
+	requestID string
+	finished  bool
+}
+
+func (c *Collector) StartCrashReport(ctx context.Context, report *LogReport) *LogUpload {
+	return c.startLogUpload(ctx, report, "crash")
+}
+
+func (c *Collector) StartBacktrace(ctx context.Context, report *LogReport) *LogUpload {
+	return c.startLogUpload(ctx, report, "backtrace")
+}
+
+func (c *Collector) startLogUpload(ctx context.Context, report *LogReport, logType string) *LogUpload {
+	report.ID = uuid.New().String()
+	report.Timestamp = time.Now()
+	report.LogType = logType
+	report.S3Key = c.s3Client.GenerateLogKey(report.DeviceUID, report.ID, logType)
+
+	telemetry.ActiveUploads.WithLabelValues(logType).Inc()
+
+	u := &LogUpload{
+		collector: c,
+		report:    report,
+		upload:    c.s3Client.NewUpload(ctx, report.S3Key),
+		digest:    sha256.New(),
+		requestID: requestid.FromContext(ctx),
+	}
+	if logType != "techsupport" {
+		u.capture = &bytes.Buffer{}
+	}
+	// A declared digest is stored with the object even if it ends up in a
+	// multipart upload, whose metadata is fixed when it starts. Content that
+	// does not match it is never completed.
+	if report.ExpectedSHA256 != "" {
+		u.upload.SetMetadata(map[string]string{"sha256": strings.ToLower(report.ExpectedSHA256)})
+	}
+	return u
+}
+
+func (u *LogUpload) Write(p []byte) (int, error) {
+	if u.capture != nil && u.capture.Len() < maxCapture {
+		u.capture.Write(p[:min(len(p), maxCapture-u.capture.Len())])
+	}
+	n, err := u.upload.Write(p)
+	u.digest.Write(p[:n])
+	return n, err
+}
+
+// finish must be called once the upload is committed or aborted.
+func (u *LogUpload) finish() {
+	if !u.finished {
+		u.finished = true
+		telemetry.ActiveUploads.WithLabelValues(u.report.LogType).Dec()
+	}
+}
+
+func (u *LogUpload) Commit() (string, error) {
+	c := u.collector
+	report := u.report
+	defer u.finish()
+
+	digest := hex.EncodeToString(u.digest.Sum(nil))
+	if err := u.verify(digest); err != nil {
+		c.logger.Warn("Rejected "+report.LogType+" upload",
+			zap.String("device_uid", report.DeviceUID),
+			zap.String("log_id", report.ID),
+			zap.Error(err),
+		)
+		u.Abort()
+		return "", err
+	}
+
+	// Without a declared digest only a single-part upload, which has not
+	// reached S3 yet, can still take the computed one.
+	u.upload.SetMetadata(map[string]string{"sha256": digest})
+	if err := u.upload.Complete(); err != nil {
+		c.logger.Error("Failed to upload "+report.LogType+" to S3",
+			zap.String("device_uid", report.DeviceUID),
+			zap.String("log_id", report.ID),
+			zap.Error(err),
+		)
+		return "", err
+	}
+
+	metadata := LogMetadata{
+		LogID:      report.ID,
+		DeviceUID:  report.DeviceUID,
+		LogType:    report.LogType,
+		ProcessTag: report.ProcessTag,
+		Version:    report.Version,
+		Filename:   report.Filename,
+		S3Key:      report.S3Key,
+		Size:       u.upload.Size(),
+		SHA256:     digest,
+		Timestamp:  report.Timestamp,
+	}
+
+	if report.LogType != "techsupport" {
+		u.analyze(&metadata)
+	}
+
+	if err := c.logIndex.Add(&metadata); err != nil {
+		c.logger.Error("Failed to index "+report.LogType+" metadata",
+			zap.String("log_id", report.ID),
+			zap.Error(err),
+		)
+	}
+
+	if report.LogType == "techsupport" {
+		c.indexArchive(&metadata, u.requestID)
+	} else if err := c.sendLogMetadata(&metadata, u.requestID); err != nil {
+		c.logger.Warn("Failed to send "+report.LogType+" metadata to Splunk",
+			zap.String("log_id", report.ID),
+			zap.Error(err),
+		)
+	}
+
+	c.logger.Info("Log stored",
+		zap.String("log_id", report.ID),
+		zap.String("log_type", report.LogType),
+		zap.String("device_uid", report.DeviceUID),
+		zap.String("s3_key", report.S3Key),
+		zap.Int64("size", u.upload.Size()),
+	)
+
+	return report.ID, nil
+}
+
+// verify checks the content against the size and digest the device declared.
+func (u *LogUpload) verify(digest string) error {
+	report := u.report
+	if size := u.upload.Size(); report.ExpectedSize > 0 && size != report.ExpectedSize {
+		return fmt.Errorf("%w: received %d bytes, expected %d", ErrIntegrity, size, report.ExpectedSize)
+	}
+	if report.ExpectedSHA256 != "" && !strings.EqualFold(digest, report.ExpectedSHA256) {
+		return fmt.Errorf("%w: sha256 is %s, expected %s", ErrIntegrity, digest, strings.ToLower(report.ExpectedSHA256))
+	}
+	return nil
+}
+
+// analyze symbolizes a backtrace, parses the log and buckets a crash report.
+func (u *LogUpload) analyze(metadata *LogMetadata) {
+	c := u.collector
+	report := u.report
+
+	text := u.capture.Bytes()
+	if report.LogType == "backtrace" && c.symbols != nil {
+		if symbolized, key := u.symbolize(); key != "" {
+			metadata.SymbolizedKey = key
+			text = symbolized
+		}
+	}
+
+	analysis, ok := c.parsers.Parse(string(text))
+	if !ok {
+		if report.LogType == "crash" {
+			c.logger.Info("No known crash format found in crash report", zap.String("log_id", report.ID))
+		}
+		return
+	}
+
+	metadata.setAnalysis(analysis)
+	if report.LogType == "crash" && c.crashes != nil {
+		metadata.Signature = u.bucket(analysis.Frames)
+	}
+}
+
+// bucket assigns the crash report to its crash bucket by its parsed frames and
+// returns the signature, or "" when the frames are not usable.
+func (u *LogUpload) bucket(frames []crash.Frame) string {
+	c := u.collector
+	report := u.report
+
+	sig, ok := c.crashes.Classify(report.ProcessTag, frames)
+	if !ok {
+		c.logger.Info("No backtrace found in crash report", zap.String("log_id", report.ID))
+		return ""
+	}
+
+	bucket, err := c.crashes.Add(sig, report.DeviceUID, report.Version, report.Timestamp)
+	if err != nil {
+		c.logger.Error("Failed to record crash bucket",
+			zap.String("log_id", report.ID),
+			zap.String("signature", sig.ID),
+			zap.Error(err),
+		)
+		return sig.ID
+	}
+
+	c.logger.Info("Crash report bucketed",
+		zap.String("log_id", report.ID),
+		zap.String("signature", sig.ID),
+		zap.String("process_tag", sig.ProcessTag),
+		zap.Int("count", bucket.Count),
+		zap.Int("devices", len(bucket.Devices)),
+	)
+	return sig.ID
+}
+
+// symbolize stores the symbolized backtrace next to the raw one and returns
+// it with its S3 key, or "" when no frame could be resolved.
+func (u *LogUpload) symbolize() ([]byte, string) {
+	c := u.collector
+	report := u.report
+
+	text, resolved := c.symbols.Symbolize(report.Version, report.ProcessTag, u.capture.Bytes())
+	if resolved == 0 {
+		return nil, ""
+	}
+
+	key := report.S3Key + ".symbolized"
+	if err := c.s3Client.Upload(key, text); err != nil {
+		c.logger.Error("Failed to upload symbolized backtrace to S3",
+			zap.String("log_id", report.ID),
+			zap.String("s3_key", key),
+			zap.Error(err),
+		)
+		return nil, ""
+	}
+
+	c.logger.Info("Backtrace symbolized",
+		zap.String("log_id", report.ID),
+		zap.String("version", report.Version),
+		zap.String("process_tag", report.ProcessTag),
+		zap.Int("frames", resolved),
+	)
+	return text, key
+}
+
+// Abort discards everything uploaded so far. It is a no-op after Commit.
+func (u *LogUpload) Abort() {
+	u.finish()
+	if err := u.upload.Abort(); err != nil {
+		u.collector.logger.Warn("Failed to abort log upload",
+			zap.String("log_id", u.report.ID),
+			zap.String("s3_key", u.report.S3Key),
+			zap.Error(err),
+		)
+	}
+}
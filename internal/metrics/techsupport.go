@@ -0,0 +1,299 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/storage"
+	"github.com/vtapaskar/brahma/internal/techsupport"
+	"go.uber.org/zap"
+)
+
+// ErrNoManifest is returned for logs that are not an indexed techsupport
+// archive.
+var ErrNoManifest = errors.New("log has no techsupport manifest")
+
+// ErrIndexPending is returned for techsupport archives that are stored but
+// not indexed yet.
+var ErrIndexPending = errors.New("techsupport archive is not indexed yet")
+
+// ErrIndexFailed is returned for techsupport archives that could not be
+// indexed; they will not be retried.
+var ErrIndexFailed = errors.New("techsupport archive could not be indexed")
+
+// ErrMemberNotFound is returned by OpenLogMember for paths the archive does
+// not contain.
+var ErrMemberNotFound = errors.New("techsupport member not found")
+
+// maxConcurrentIndexing bounds how many archives are read back from S3 and
+// indexed at once.
+const maxConcurrentIndexing = 2
+
+// An archive whose indexing fails is tried maxIndexAttempts times, waiting
+// twice as long before each retry, before the failure is recorded.
+const (
+	maxIndexAttempts  = 5
+	indexRetryBackoff = 30 * time.Second
+)
+
+func (c *Collector) StartTechSupport(ctx context.Context, report *LogReport) *LogUpload {
+	return c.startLogUpload(ctx, report, "techsupport")
+}
+
+// indexArchive indexes a committed techsupport archive in the background,
+// reading it back from S3, so the upload stream only ever waits on the
+// archive's own S3 upload. Failures are retried with backoff; once the
+// attempts run out, or the archive is gone from S3, the failure is recorded
+// in the log index so readers get a final answer. The log metadata is sent
+// to the sinks either way.
+func (c *Collector) indexArchive(metadata *LogMetadata, requestID string) {
+	c.indexing.Add(1)
+	go func() {
+		defer c.indexing.Done()
+
+		backoff := indexRetryBackoff
+		for attempt := 1; ; attempt++ {
+			select {
+			case c.indexSlots <- struct{}{}:
+			case <-c.indexCtx.Done():
+				return
+			}
+			indexed, err := c.storeManifest(metadata)
+			<-c.indexSlots
+			if c.indexCtx.Err() != nil {
+				// Indexed again on the next start.
+				return
+			}
+
+			if err != nil && attempt < maxIndexAttempts && !storage.IsNotFound(err) {
+				c.logger.Warn("Failed to index techsupport archive, retrying",
+					zap.String("log_id", metadata.LogID),
+					zap.Int("attempt", attempt),
+					zap.Duration("backoff", backoff),
+					zap.Error(err),
+				)
+				timer := time.NewTimer(backoff)
+				select {
+				case <-timer.C:
+				case <-c.indexCtx.Done():
+					timer.Stop()
+					return
+				}
+				backoff *= 2
+				continue
+			}
+			if err != nil {
+				c.logger.Error("Failed to index techsupport archive",
+					zap.String("log_id", metadata.LogID),
+					zap.Int("attempts", attempt),
+					zap.Error(err),
+				)
+				indexed = c.recordIndexFailure(metadata, err)
+			}
+
+			if err := c.sendLogMetadata(indexed, requestID); err != nil {
+				c.logger.Warn("Failed to send techsupport metadata to Splunk",
+					zap.String("log_id", indexed.LogID),
+					zap.Error(err),
+				)
+			}
+			return
+		}
+	}()
+}
+
+// resumeIndexing indexes the archives that were stored but neither indexed
+// nor given up on before the last shutdown.
+func (c *Collector) resumeIndexing() {
+	for offset := 0; ; offset += maxLogListLimit {
+		page, total := c.logIndex.List(LogQuery{LogType: "techsupport", Limit: maxLogListLimit, Offset: offset})
+		for _, metadata := range page {
+			if metadata.ManifestKey == "" && metadata.IndexError == "" {
+				c.indexArchive(metadata, "")
+			}
+		}
+		if offset+len(page) >= total {
+			return
+		}
+	}
+}
+
+// storeManifest indexes an archive and stores its manifest, returning the
+// updated metadata. The escalation members are copied to their own objects
+// under <archive key>.members/ on the way. A malformed, truncated or oversized
+// archive keeps the members read before the problem; an error means no
+// manifest was stored.
+func (c *Collector) storeManifest(metadata *LogMetadata) (*LogMetadata, error) {
+	ctx := c.indexCtx
+
+	body, _, err := c.s3Client.Open(ctx, metadata.S3Key)
+	if err != nil {
+		return nil, err
+	}
+	extract := func(name string, content io.Reader) (string, error) {
+		key := metadata.S3Key + ".members/" + name
+		upload := c.s3Client.NewUpload(ctx, key)
+		_, err := io.Copy(upload, content)
+		if err == nil {
+			err = upload.Complete()
+		} else {
+			upload.Abort()
+		}
+		if err != nil {
+			c.logger.Warn("Failed to store techsupport member",
+				zap.String("log_id", metadata.LogID),
+				zap.String("member", name),
+				zap.Error(err),
+			)
+			return "", err
+		}
+		return key, nil
+	}
+	manifest, err := techsupport.Index(body, c.techsupportLimits, extract)
+	body.Close()
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		c.logger.Warn("Techsupport archive only partially indexed",
+			zap.String("log_id", metadata.LogID),
+			zap.Int("members", len(manifest.Members)),
+			zap.Error(err),
+		)
+	}
+
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal techsupport manifest: %w", err)
+	}
+	key := metadata.S3Key + ".manifest.json"
+	if err := c.s3Client.Upload(key, data); err != nil {
+		return nil, err
+	}
+
+	// Entries in the log index are shared with readers, so store a copy.
+	indexed := *metadata
+	indexed.ManifestKey = key
+	indexed.MemberCount = len(manifest.Members)
+	if err := c.logIndex.Add(&indexed); err != nil {
+		c.logger.Error("Failed to index techsupport metadata",
+			zap.String("log_id", metadata.LogID),
+			zap.Error(err),
+		)
+	}
+
+	c.logger.Info("Techsupport archive indexed",
+		zap.String("log_id", metadata.LogID),
+		zap.Int("members", indexed.MemberCount),
+	)
+	return &indexed, nil
+}
+
+// recordIndexFailure stores why an archive could not be indexed, returning
+// the updated metadata.
+func (c *Collector) recordIndexFailure(metadata *LogMetadata, cause error) *LogMetadata {
+	failed := *metadata
+	failed.IndexError = cause.Error()
+	if err := c.logIndex.Add(&failed); err != nil {
+		c.logger.Error("Failed to index techsupport metadata",
+			zap.String("log_id", metadata.LogID),
+			zap.Error(err),
+		)
+	}
+	return &failed
+}
+
+// GetTechSupportManifest loads the manifest of an indexed techsupport
+// archive.
+func (c *Collector) GetTechSupportManifest(ctx context.Context, metadata *LogMetadata) (*techsupport.Manifest, error) {
+	if metadata.ManifestKey == "" {
+		switch {
+		case metadata.IndexError != "":
+			return nil, fmt.Errorf("%w: %s", ErrIndexFailed, metadata.IndexError)
+		case metadata.LogType == "techsupport":
+			return nil, ErrIndexPending
+		}
+		return nil, ErrNoManifest
+	}
+
+	body, _, err := c.s3Client.Open(ctx, metadata.ManifestKey)
+	if err != nil {
+		c.logger.Error("Failed to open techsupport manifest",
+			zap.String("log_id", metadata.LogID),
+			zap.String("s3_key", metadata.ManifestKey),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	defer body.Close()
+
+	var manifest techsupport.Manifest
+	if err := json.NewDecoder(body).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to decode techsupport manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// memberBody is a techsupport member decompressed from the archive body it
+// closes.
+type memberBody struct {
+	io.Reader
+	archive io.Closer
+}
+
+func (b *memberBody) Close() error {
+	return b.archive.Close()
+}
+
+// OpenLogMember streams one member of an indexed techsupport archive. A
+// member copied out while indexing is read from its own object; for the rest,
+// only the part of the archive up to the end of the member is read from S3.
+func (c *Collector) OpenLogMember(ctx context.Context, metadata *LogMetadata, name string) (io.ReadCloser, error) {
+	manifest, err := c.GetTechSupportManifest(ctx, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	member, ok := manifest.Find(name)
+	if !ok {
+		return nil, ErrMemberNotFound
+	}
+
+	if member.Key != "" {
+		body, _, err := c.s3Client.Open(ctx, member.Key)
+		if err != nil {
+			c.logger.Error("Failed to open techsupport member",
+				zap.String("log_id", metadata.LogID),
+				zap.String("member", member.Path),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		return body, nil
+	}
+
+	body, err := c.s3Client.OpenRange(ctx, metadata.S3Key, 0, member.ArchiveEnd)
+	if err != nil {
+		c.logger.Error("Failed to open techsupport member",
+			zap.String("log_id", metadata.LogID),
+			zap.String("member", member.Path),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	content, err := techsupport.OpenMember(body, member)
+	if err != nil {
+		body.Close()
+		c.logger.Error("Failed to open techsupport member",
+			zap.String("log_id", metadata.LogID),
+			zap.String("member", member.Path),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return &memberBody{Reader: content, archive: body}, nil
+}
@@ -1,6 +1,10 @@
 package metrics
 
-import "time"
+import (
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/models"
+)
 
 type CPUStats struct {
 	UID            string    `json:"uid"`
@@ -125,3 +129,27 @@ type LLDPNeighbor struct {
 	RemotePortDesc string `json:"remote_port_desc,omitempty"`
 	TTL            int    `json:"ttl"`
 }
+
+type InterfaceStats struct {
+	UID        string                   `json:"uid"`
+	Timestamp  time.Time                `json:"timestamp"`
+	Interfaces []models.InterfaceMetric `json:"interfaces"`
+}
+
+type BGPNeighborStats struct {
+	UID       string             `json:"uid"`
+	Timestamp time.Time          `json:"timestamp"`
+	Neighbors []models.BGPMetric `json:"neighbors"`
+}
+
+type VXLANTunnelStats struct {
+	UID       string               `json:"uid"`
+	Timestamp time.Time            `json:"timestamp"`
+	Tunnels   []models.VXLANMetric `json:"tunnels"`
+}
+
+type QoSQueueStats struct {
+	UID       string             `json:"uid"`
+	Timestamp time.Time          `json:"timestamp"`
+	Queues    []models.QoSMetric `json:"queues"`
+}
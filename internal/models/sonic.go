@@ -62,6 +62,7 @@ type VXLANMetric struct {
 }
 
 type QoSMetric struct {
+	Interface      string `json:"interface"`
 	Queue          string `json:"queue"`
 	Priority       int    `json:"priority"`
 	PacketsQueued  uint64 `json:"packets_queued"`
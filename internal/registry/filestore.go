@@ -0,0 +1,238 @@
+package registry
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/vtapaskar/brahma/internal/fsutil"
+)
+
+const (
+	snapshotFile = "registry.json"
+	journalFile  = "registry.journal"
+
+	defaultCompactionThreshold = 1000
+)
+
+type journalEntry struct {
+	Op     string              `json:"op"`
+	UID    string              `json:"uid"`
+	Device *DeviceRegistration `json:"device,omitempty"`
+}
+
+// FileStore keeps registrations in a snapshot file plus an append-only
+// journal of changes made since the snapshot was written. Every change is
+// fsynced to the journal before the call returns; once the journal grows past
+// the compaction threshold the current state is written to a fresh snapshot
+// and the journal is truncated.
+type FileStore struct {
+	dir       string
+	threshold int
+	devices   map[string]*DeviceRegistration
+	journal   *os.File
+	entries   int
+}
+
+func NewFileStore(dir string, compactionThreshold int) (*FileStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("registry data_dir is required for the file backend")
+	}
+	if compactionThreshold <= 0 {
+		compactionThreshold = defaultCompactionThreshold
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create registry directory: %w", err)
+	}
+
+	fs := &FileStore{
+		dir:       dir,
+		threshold: compactionThreshold,
+		devices:   make(map[string]*DeviceRegistration),
+	}
+
+	if err := fs.readSnapshot(); err != nil {
+		return nil, err
+	}
+	if err := fs.replayJournal(); err != nil {
+		return nil, err
+	}
+
+	// Start every run from a clean snapshot so replay time stays bounded.
+	if err := fs.compact(); err != nil {
+		return nil, err
+	}
+
+	return fs, nil
+}
+
+func (fs *FileStore) readSnapshot() error {
+	data, err := os.ReadFile(filepath.Join(fs.dir, snapshotFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read registry snapshot: %w", err)
+	}
+
+	var devices []*DeviceRegistration
+	if err := json.Unmarshal(data, &devices); err != nil {
+		return fmt.Errorf("failed to decode registry snapshot: %w", err)
+	}
+
+	for _, d := range devices {
+		fs.devices[d.UID] = d
+	}
+
+	return nil
+}
+
+func (fs *FileStore) replayJournal() error {
+	file, err := os.Open(filepath.Join(fs.dir, journalFile))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open registry journal: %w", err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry journalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			// Only the last record can be torn, and it was never
+			// acknowledged to the caller, so stop replaying here.
+			break
+		}
+		fs.apply(entry)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read registry journal: %w", err)
+	}
+
+	return nil
+}
+
+func (fs *FileStore) apply(entry journalEntry) {
+	switch entry.Op {
+	case "put":
+		if entry.Device != nil {
+			fs.devices[entry.Device.UID] = entry.Device
+		}
+	case "delete":
+		delete(fs.devices, entry.UID)
+	}
+}
+
+func (fs *FileStore) Load() ([]*DeviceRegistration, error) {
+	devices := make([]*DeviceRegistration, 0, len(fs.devices))
+	for _, d := range fs.devices {
+		copied := *d
+		devices = append(devices, &copied)
+	}
+	return devices, nil
+}
+
+func (fs *FileStore) Put(device *DeviceRegistration) error {
+	copied := *device
+	return fs.append(journalEntry{Op: "put", UID: device.UID, Device: &copied})
+}
+
+func (fs *FileStore) Delete(uid string) error {
+	return fs.append(journalEntry{Op: "delete", UID: uid})
+}
+
+// Replace writes devices straight to a new snapshot, which costs one fsync
+// however many devices there are.
+func (fs *FileStore) Replace(devices []*DeviceRegistration) error {
+	fs.devices = make(map[string]*DeviceRegistration, len(devices))
+	for _, d := range devices {
+		copied := *d
+		fs.devices[d.UID] = &copied
+	}
+	return fs.compact()
+}
+
+func (fs *FileStore) append(entry journalEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal journal entry: %w", err)
+	}
+
+	if _, err := fs.journal.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write registry journal: %w", err)
+	}
+	if err := fs.journal.Sync(); err != nil {
+		return fmt.Errorf("failed to sync registry journal: %w", err)
+	}
+
+	fs.apply(entry)
+	fs.entries++
+
+	if fs.entries >= fs.threshold {
+		return fs.compact()
+	}
+
+	return nil
+}
+
+func (fs *FileStore) compact() error {
+	devices := make([]*DeviceRegistration, 0, len(fs.devices))
+	for _, d := range fs.devices {
+		devices = append(devices, d)
+	}
+	sort.Slice(devices, func(i, j int) bool { return devices[i].UID < devices[j].UID })
+
+	data, err := json.MarshalIndent(devices, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal registry snapshot: %w", err)
+	}
+
+	tmp := filepath.Join(fs.dir, snapshotFile+".tmp")
+	if err := fsutil.WriteFileSync(tmp, data); err != nil {
+		return fmt.Errorf("failed to write registry snapshot: %w", err)
+	}
+	if err := os.Rename(tmp, filepath.Join(fs.dir, snapshotFile)); err != nil {
+		return fmt.Errorf("failed to install registry snapshot: %w", err)
+	}
+	// The rename is only durable once the directory is synced; until then a
+	// power loss could bring back the old snapshot next to an empty journal.
+	if err := fsutil.SyncDir(fs.dir); err != nil {
+		return fmt.Errorf("failed to sync registry directory: %w", err)
+	}
+
+	if fs.journal != nil {
+		fs.journal.Close()
+	}
+
+	journal, err := os.OpenFile(filepath.Join(fs.dir, journalFile), os.O_CREATE|os.O_TRUNC|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to reset registry journal: %w", err)
+	}
+	fs.journal = journal
+	fs.entries = 0
+
+	return nil
+}
+
+// Close compacts the journal unless nothing was written since the last
+// snapshot.
+func (fs *FileStore) Close() error {
+	if fs.journal == nil {
+		return nil
+	}
+	var err error
+	if fs.entries > 0 {
+		err = fs.compact()
+	}
+	fs.journal.Close()
+	fs.journal = nil
+	return err
+}
@@ -0,0 +1,121 @@
+package registry
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func loadUIDs(t *testing.T, fs *FileStore) string {
+	t.Helper()
+	devices, err := fs.Load()
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	var uids []string
+	for _, d := range devices {
+		uids = append(uids, d.UID+"="+d.Hostname)
+	}
+	sort.Strings(uids)
+	return strings.Join(uids, ",")
+}
+
+func TestFileStoreReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	fs, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	fs.Put(&DeviceRegistration{UID: "a", Hostname: "leaf1"})
+	fs.Put(&DeviceRegistration{UID: "b", Hostname: "leaf2"})
+	fs.Put(&DeviceRegistration{UID: "a", Hostname: "leaf1-renamed"})
+	fs.Delete("b")
+	fs.Put(&DeviceRegistration{UID: "c", Hostname: "spine1"})
+
+	// No Close: the journal alone must bring the state back after a crash.
+	reopened, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore after crash: %v", err)
+	}
+	defer reopened.Close()
+	if got, want := loadUIDs(t, reopened), "a=leaf1-renamed,c=spine1"; got != want {
+		t.Errorf("replayed %s, want %s", got, want)
+	}
+}
+
+func TestFileStoreTornTail(t *testing.T) {
+	dir := t.TempDir()
+
+	fs, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	fs.Put(&DeviceRegistration{UID: "a", Hostname: "leaf1"})
+
+	journal, err := os.OpenFile(filepath.Join(dir, journalFile), os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	journal.WriteString(`{"op":"put","uid":"b","device":{"uid":"b","hostn`)
+	journal.Close()
+
+	reopened, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore with a torn journal: %v", err)
+	}
+	if got, want := loadUIDs(t, reopened), "a=leaf1"; got != want {
+		t.Errorf("loaded %s, want %s", got, want)
+	}
+
+	// The torn record must not swallow what is written after it.
+	reopened.Put(&DeviceRegistration{UID: "c", Hostname: "spine1"})
+	again, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer again.Close()
+	if got, want := loadUIDs(t, again), "a=leaf1,c=spine1"; got != want {
+		t.Errorf("loaded %s, want %s", got, want)
+	}
+}
+
+func TestFileStoreCompaction(t *testing.T) {
+	dir := t.TempDir()
+
+	fs, err := NewFileStore(dir, 3)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	fs.Put(&DeviceRegistration{UID: "a", Hostname: "leaf1"})
+	fs.Put(&DeviceRegistration{UID: "b", Hostname: "leaf2"})
+	if info, _ := os.Stat(filepath.Join(dir, journalFile)); info.Size() == 0 {
+		t.Fatal("journal empty before reaching the compaction threshold")
+	}
+	fs.Delete("a")
+	if info, _ := os.Stat(filepath.Join(dir, journalFile)); info.Size() != 0 {
+		t.Errorf("journal has %d bytes after compaction", info.Size())
+	}
+
+	if err := fs.Replace([]*DeviceRegistration{{UID: "b", Hostname: "leaf2"}, {UID: "d", Hostname: "spine2"}}); err != nil {
+		t.Fatalf("Replace: %v", err)
+	}
+	if err := fs.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Only the snapshot is left to load from.
+	if info, _ := os.Stat(filepath.Join(dir, journalFile)); info.Size() != 0 {
+		t.Errorf("journal has %d bytes after Close", info.Size())
+	}
+	reopened, err := NewFileStore(dir, 3)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	defer reopened.Close()
+	if got, want := loadUIDs(t, reopened), "b=leaf2,d=spine2"; got != want {
+		t.Errorf("loaded %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,186 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"go.uber.org/zap"
+)
+
+type DeviceState string
+
+const (
+	StateOnline  DeviceState = "online"
+	StateStale   DeviceState = "stale"
+	StateOffline DeviceState = "offline"
+)
+
+const (
+	defaultStaleAfter       = 90 * time.Second
+	defaultOfflineAfter     = 5 * time.Minute
+	defaultLivenessInterval = 15 * time.Second
+)
+
+func ParseDeviceState(s string) (DeviceState, error) {
+	switch state := DeviceState(s); state {
+	case StateOnline, StateStale, StateOffline:
+		return state, nil
+	}
+	return "", fmt.Errorf("unknown device state %q", s)
+}
+
+type stateChange struct {
+	device   DeviceRegistration
+	previous DeviceState
+}
+
+// setState must be called with mu held. It returns a snapshot of the device
+// for the Splunk event, which is sent once the lock is released.
+func (r *Registry) setState(device *DeviceRegistration, state DeviceState) (stateChange, bool) {
+	if device.State == state {
+		return stateChange{}, false
+	}
+
+	previous := *device
+	device.State = state
+	r.record(ChangeModified, device, &previous)
+	countDevice(previous.State, state)
+
+	return stateChange{device: *device, previous: previous.State}, true
+}
+
+// sendStateEvent reports a device going offline or coming back. Devices that
+// merely turn stale are only logged, so Splunk alerts fire on offline alone.
+func (r *Registry) sendStateEvent(ctx context.Context, change stateChange) {
+	device := &change.device
+
+	switch device.State {
+	case StateStale:
+		r.logger.Warn("Device is stale",
+			zap.String("uid", device.UID),
+			zap.String("foreign_key", device.ForeignKey),
+			zap.Time("last_seen", device.LastSeen),
+		)
+		return
+	case StateOffline:
+		r.logger.Warn("Device went offline",
+			zap.String("uid", device.UID),
+			zap.String("foreign_key", device.ForeignKey),
+			zap.Time("last_seen", device.LastSeen),
+		)
+		r.sendDeviceEvent(ctx, device, "device_offline", map[string]interface{}{"previous_state": change.previous})
+	case StateOnline:
+		r.logger.Info("Device is back online",
+			zap.String("uid", device.UID),
+			zap.String("foreign_key", device.ForeignKey),
+			zap.String("previous_state", string(change.previous)),
+		)
+		r.sendDeviceEvent(ctx, device, "device_online", map[string]interface{}{"previous_state": change.previous})
+	}
+}
+
+// LivenessMonitor periodically moves devices that have stopped reporting
+// from online to stale to offline. Devices return to online as soon as the
+// registry sees them again.
+type LivenessMonitor struct {
+	registry     *Registry
+	staleAfter   time.Duration
+	offlineAfter time.Duration
+	interval     time.Duration
+	logger       *zap.Logger
+	stopChan     chan struct{}
+	done         chan struct{}
+}
+
+func NewLivenessMonitor(cfg config.RegistryConfig, reg *Registry, logger *zap.Logger) *LivenessMonitor {
+	m := &LivenessMonitor{
+		registry:     reg,
+		staleAfter:   time.Duration(cfg.StaleAfterSeconds) * time.Second,
+		offlineAfter: time.Duration(cfg.OfflineAfterSeconds) * time.Second,
+		interval:     time.Duration(cfg.LivenessIntervalSeconds) * time.Second,
+		logger:       logger,
+		stopChan:     make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+
+	if m.staleAfter <= 0 {
+		m.staleAfter = defaultStaleAfter
+	}
+	if m.offlineAfter <= 0 {
+		m.offlineAfter = defaultOfflineAfter
+	}
+	if m.offlineAfter <= m.staleAfter {
+		m.offlineAfter = m.staleAfter + defaultOfflineAfter
+	}
+	if m.interval <= 0 {
+		m.interval = defaultLivenessInterval
+	}
+
+	return m
+}
+
+func (m *LivenessMonitor) Run() {
+	defer close(m.done)
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case now := <-ticker.C:
+			m.check(now)
+		case <-m.stopChan:
+			return
+		}
+	}
+}
+
+func (m *LivenessMonitor) Stop() {
+	close(m.stopChan)
+	<-m.done
+}
+
+func (m *LivenessMonitor) stateAt(lastSeen, now time.Time) DeviceState {
+	silent := now.Sub(lastSeen)
+	switch {
+	case silent >= m.offlineAfter:
+		return StateOffline
+	case silent >= m.staleAfter:
+		return StateStale
+	default:
+		return StateOnline
+	}
+}
+
+// check moves silent devices to stale or offline. Silence is counted from no
+// earlier than the registry load, since the persisted LastSeen may be as old
+// as the last registration change and devices could not report while Brahma
+// was down. Only a report brings a device back online, so a device loaded as
+// offline stays offline through that grace period.
+func (m *LivenessMonitor) check(now time.Time) {
+	r := m.registry
+
+	r.mu.Lock()
+	var changes []stateChange
+	for _, device := range r.devices {
+		lastSeen := device.LastSeen
+		if lastSeen.Before(r.loadedAt) {
+			lastSeen = r.loadedAt
+		}
+
+		state := m.stateAt(lastSeen, now)
+		if state == StateOnline && device.State != StateOnline {
+			continue
+		}
+		if change, ok := r.setState(device, state); ok {
+			changes = append(changes, change)
+		}
+	}
+	r.mu.Unlock()
+
+	for _, change := range changes {
+		r.sendStateEvent(context.Background(), change)
+	}
+}
@@ -0,0 +1,54 @@
+package registry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"go.uber.org/zap"
+)
+
+func TestLivenessGraceAfterLoad(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	dayAgo := time.Now().Add(-24 * time.Hour)
+	fs.Put(&DeviceRegistration{UID: "quiet", ForeignKey: "leaf1", LastSeen: dayAgo, State: StateOnline})
+	fs.Put(&DeviceRegistration{UID: "down", ForeignKey: "leaf2", LastSeen: dayAgo, State: StateOffline})
+	fs.Close()
+
+	reopened, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	r, err := NewRegistry(config.RegistryConfig{}, reopened, discardSink{}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	defer r.Close()
+
+	m := NewLivenessMonitor(config.RegistryConfig{StaleAfterSeconds: 60, OfflineAfterSeconds: 300}, r, zap.NewNop())
+
+	states := func() (DeviceState, DeviceState) {
+		quiet, _ := r.Snapshot("quiet")
+		down, _ := r.Snapshot("down")
+		return quiet.State, down.State
+	}
+
+	m.check(r.loadedAt.Add(30 * time.Second))
+	if quiet, down := states(); quiet != StateOnline || down != StateOffline {
+		t.Errorf("within the grace period: quiet %s, down %s; want online, offline", quiet, down)
+	}
+
+	m.check(r.loadedAt.Add(2 * time.Minute))
+	if quiet, _ := states(); quiet != StateStale {
+		t.Errorf("after stale_after: quiet %s, want stale", quiet)
+	}
+
+	m.check(r.loadedAt.Add(10 * time.Minute))
+	if quiet, _ := states(); quiet != StateOffline {
+		t.Errorf("after offline_after: quiet %s, want offline", quiet)
+	}
+}
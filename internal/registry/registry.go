@@ -1,11 +1,19 @@
 package registry
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
-	"github.com/vtapaskar/brahma/internal/splunk"
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/requestid"
+	"github.com/vtapaskar/brahma/internal/sink"
+	"github.com/vtapaskar/brahma/internal/telemetry"
 	"go.uber.org/zap"
 )
 
@@ -20,6 +28,30 @@ type DeviceRegistration struct {
 	Labels       map[string]string `json:"labels,omitempty"`
 	RegisteredAt time.Time         `json:"registered_at"`
 	LastSeen     time.Time         `json:"last_seen"`
+	State        DeviceState       `json:"state,omitempty"`
+
+	TokenHash     string    `json:"token_hash,omitempty"`
+	TokenIssuedAt time.Time `json:"token_issued_at"`
+	TokenRevoked  bool      `json:"token_revoked,omitempty"`
+}
+
+// clone returns a copy of device that callers can keep and read without the
+// registry lock while the registry goes on updating the original.
+func (d *DeviceRegistration) clone() *DeviceRegistration {
+	copied := *d
+	copied.Labels = cloneLabels(d.Labels)
+	return &copied
+}
+
+func cloneLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(labels))
+	for k, v := range labels {
+		copied[k] = v
+	}
+	return copied
 }
 
 type RegistrationRequest struct {
@@ -32,45 +64,141 @@ type RegistrationRequest struct {
 	Labels     map[string]string `json:"labels,omitempty"`
 }
 
+const (
+	defaultQueryLimit = 100
+	maxQueryLimit     = 1000
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// DeviceQuery filters and pages through registered devices. Empty fields
+// match every device. PageToken is the NextPageToken of a previous page;
+// Offset is only honoured without one, for clients that predate page tokens.
+type DeviceQuery struct {
+	Selector   Selector
+	DeviceType string
+	Platform   string
+	Version    string
+	State      DeviceState
+	Limit      int
+	Offset     int
+	PageToken  string
+}
+
+func (q DeviceQuery) matches(device *DeviceRegistration) bool {
+	if q.DeviceType != "" && device.DeviceType != q.DeviceType {
+		return false
+	}
+	if q.Platform != "" && device.Platform != q.Platform {
+		return false
+	}
+	if q.Version != "" && device.Version != q.Version {
+		return false
+	}
+	if q.State != "" && device.State != q.State {
+		return false
+	}
+	return q.Selector.Matches(device.Labels)
+}
+
 type Registry struct {
 	devices      map[string]*DeviceRegistration
 	byForeignKey map[string]string
 	mu           sync.RWMutex
-	splunkClient *splunk.Client
+	store        Store
+	sink         sink.Sink
 	logger       *zap.Logger
+
+	revision     uint64
+	changes      []Change
+	watchHistory int
+	watchers     map[*Watcher]struct{}
+	closed       bool
+
+	// loadedAt is when the registry was loaded; liveness counts silence
+	// from no earlier than this.
+	loadedAt time.Time
 }
 
-func NewRegistry(splunkClient *splunk.Client, logger *zap.Logger) *Registry {
-	return &Registry{
+func NewRegistry(cfg config.RegistryConfig, store Store, eventSink sink.Sink, logger *zap.Logger) (*Registry, error) {
+	r := &Registry{
 		devices:      make(map[string]*DeviceRegistration),
 		byForeignKey: make(map[string]string),
-		splunkClient: splunkClient,
+		store:        store,
+		sink:         eventSink,
 		logger:       logger,
+		revision:     initialRevision(),
+		watchHistory: cfg.WatchHistory,
+		watchers:     make(map[*Watcher]struct{}),
+		loadedAt:     time.Now(),
 	}
+
+	if r.watchHistory <= 0 {
+		r.watchHistory = defaultWatchHistory
+	}
+
+	devices, err := store.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load registry: %w", err)
+	}
+
+	for _, device := range devices {
+		if device.State == "" {
+			device.State = StateOnline
+		}
+		r.devices[device.UID] = device
+		r.byForeignKey[device.ForeignKey] = device.UID
+		countDevice("", device.State)
+	}
+
+	if len(devices) > 0 {
+		r.logger.Info("Loaded device registry", zap.Int("devices", len(devices)))
+	}
+
+	return r, nil
 }
 
-func (r *Registry) Register(req RegistrationRequest) (*DeviceRegistration, error) {
+// Register adds a device, or updates the one already registered under the
+// same foreign key, and returns a copy of it with a newly issued token. Any
+// previous token of the device stops working.
+func (r *Registry) Register(ctx context.Context, req RegistrationRequest) (*DeviceRegistration, string, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	if existingUID, exists := r.byForeignKey[req.ForeignKey]; exists {
+		updated := *r.devices[existingUID]
+		updated.Hostname = req.Hostname
+		updated.IPAddress = req.IPAddress
+		updated.DeviceType = req.DeviceType
+		updated.Platform = req.Platform
+		updated.Version = req.Version
+		updated.Labels = cloneLabels(req.Labels)
+		updated.LastSeen = time.Now()
+		updated.State = StateOnline
+
+		token, err := r.issueToken(&updated)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if err := r.store.Put(&updated); err != nil {
+			return nil, "", fmt.Errorf("failed to persist device: %w", err)
+		}
+
 		device := r.devices[existingUID]
-		device.Hostname = req.Hostname
-		device.IPAddress = req.IPAddress
-		device.DeviceType = req.DeviceType
-		device.Platform = req.Platform
-		device.Version = req.Version
-		device.Labels = req.Labels
-		device.LastSeen = time.Now()
+		previous := *device
+		*device = updated
+		r.record(ChangeModified, device, &previous)
+		countDevice(previous.State, device.State)
 
-		r.sendRegistrationEvent(device, "device_updated")
+		r.sendRegistrationEvent(ctx, device, "device_updated")
 
 		r.logger.Info("Device updated",
 			zap.String("uid", device.UID),
 			zap.String("foreign_key", device.ForeignKey),
 		)
 
-		return device, nil
+		return device.clone(), token, nil
 	}
 
 	device := &DeviceRegistration{
@@ -81,15 +209,27 @@ func (r *Registry) Register(req RegistrationRequest) (*DeviceRegistration, error
 		DeviceType:   req.DeviceType,
 		Platform:     req.Platform,
 		Version:      req.Version,
-		Labels:       req.Labels,
+		Labels:       cloneLabels(req.Labels),
 		RegisteredAt: time.Now(),
 		LastSeen:     time.Now(),
+		State:        StateOnline,
+	}
+
+	token, err := r.issueToken(device)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := r.store.Put(device); err != nil {
+		return nil, "", fmt.Errorf("failed to persist device: %w", err)
 	}
 
 	r.devices[device.UID] = device
 	r.byForeignKey[req.ForeignKey] = device.UID
+	r.record(ChangeAdded, device, nil)
+	countDevice("", device.State)
 
-	r.sendRegistrationEvent(device, "device_registered")
+	r.sendRegistrationEvent(ctx, device, "device_registered")
 
 	r.logger.Info("Device registered",
 		zap.String("uid", device.UID),
@@ -97,15 +237,32 @@ func (r *Registry) Register(req RegistrationRequest) (*DeviceRegistration, error
 		zap.String("hostname", device.Hostname),
 	)
 
-	return device, nil
+	return device.clone(), token, nil
 }
 
+// GetByUID returns a copy of the device. Like every device the registry
+// hands out, it does not change when the registry updates the device.
 func (r *Registry) GetByUID(uid string) (*DeviceRegistration, bool) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	device, exists := r.devices[uid]
-	return device, exists
+	if !exists {
+		return nil, false
+	}
+	return device.clone(), true
+}
+
+// Snapshot returns a copy of the device by value.
+func (r *Registry) Snapshot(uid string) (DeviceRegistration, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	device, exists := r.devices[uid]
+	if !exists {
+		return DeviceRegistration{}, false
+	}
+	return *device.clone(), true
 }
 
 func (r *Registry) GetByForeignKey(foreignKey string) (*DeviceRegistration, bool) {
@@ -118,15 +275,25 @@ func (r *Registry) GetByForeignKey(foreignKey string) (*DeviceRegistration, bool
 	}
 
 	device, exists := r.devices[uid]
-	return device, exists
+	if !exists {
+		return nil, false
+	}
+	return device.clone(), true
 }
 
-func (r *Registry) UpdateLastSeen(uid string) {
+func (r *Registry) UpdateLastSeen(ctx context.Context, uid string) {
 	r.mu.Lock()
-	defer r.mu.Unlock()
+	device, exists := r.devices[uid]
+	if !exists {
+		r.mu.Unlock()
+		return
+	}
+	device.LastSeen = time.Now()
+	change, changed := r.setState(device, StateOnline)
+	r.mu.Unlock()
 
-	if device, exists := r.devices[uid]; exists {
-		device.LastSeen = time.Now()
+	if changed {
+		r.sendStateEvent(ctx, change)
 	}
 }
 
@@ -136,12 +303,69 @@ func (r *Registry) ListDevices() []*DeviceRegistration {
 
 	devices := make([]*DeviceRegistration, 0, len(r.devices))
 	for _, device := range r.devices {
-		devices = append(devices, device)
+		devices = append(devices, device.clone())
 	}
 	return devices
 }
 
-func (r *Registry) Unregister(uid string) bool {
+// Query returns one page of devices matching q, ordered by UID, together with
+// the token for the next page (empty on the last page) and the total number
+// of matching devices. Because the cursor is the last UID returned, devices
+// registered or removed between pages never shift the remaining results.
+func (r *Registry) Query(q DeviceQuery) ([]*DeviceRegistration, string, int, error) {
+	var after string
+	if q.PageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(q.PageToken)
+		if err != nil || len(decoded) == 0 {
+			return nil, "", 0, ErrInvalidPageToken
+		}
+		after = string(decoded)
+	}
+
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	if limit > maxQueryLimit {
+		limit = maxQueryLimit
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]*DeviceRegistration, 0, len(r.devices))
+	for _, device := range r.devices {
+		if q.matches(device) {
+			matched = append(matched, device)
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool { return matched[i].UID < matched[j].UID })
+
+	start := sort.Search(len(matched), func(i int) bool { return matched[i].UID > after })
+	if q.PageToken == "" && q.Offset > 0 {
+		start = q.Offset
+		if start > len(matched) {
+			start = len(matched)
+		}
+	}
+	page := matched[start:]
+
+	var nextPageToken string
+	if len(page) > limit {
+		page = page[:limit]
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(page[limit-1].UID))
+	}
+
+	devices := make([]*DeviceRegistration, len(page))
+	for i, device := range page {
+		devices[i] = device.clone()
+	}
+
+	return devices, nextPageToken, len(matched), nil
+}
+
+func (r *Registry) Unregister(ctx context.Context, uid string) bool {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -150,10 +374,19 @@ func (r *Registry) Unregister(uid string) bool {
 		return false
 	}
 
+	if err := r.store.Delete(uid); err != nil {
+		r.logger.Error("Failed to persist device removal",
+			zap.String("uid", uid),
+			zap.Error(err),
+		)
+	}
+
 	delete(r.byForeignKey, device.ForeignKey)
 	delete(r.devices, uid)
+	r.record(ChangeDeleted, device, nil)
+	countDevice(device.State, "")
 
-	r.sendRegistrationEvent(device, "device_unregistered")
+	r.sendRegistrationEvent(ctx, device, "device_unregistered")
 
 	r.logger.Info("Device unregistered",
 		zap.String("uid", uid),
@@ -163,7 +396,49 @@ func (r *Registry) Unregister(uid string) bool {
 	return true
 }
 
-func (r *Registry) sendRegistrationEvent(device *DeviceRegistration, eventType string) {
+func (r *Registry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.closed = true
+	for w := range r.watchers {
+		r.removeWatcher(w, ErrRegistryClosed)
+	}
+
+	// LastSeen is not journaled on every heartbeat; write it out once here,
+	// as a single snapshot, so a clean restart keeps it. After a crash the
+	// persisted LastSeen is only as recent as the device's last registration
+	// change, which is why liveness does not trust it at startup.
+	devices := make([]*DeviceRegistration, 0, len(r.devices))
+	for _, device := range r.devices {
+		devices = append(devices, device)
+	}
+	if err := r.store.Replace(devices); err != nil {
+		return fmt.Errorf("failed to persist devices: %w", err)
+	}
+
+	return r.store.Close()
+}
+
+// countDevice moves a device between the per-state device gauges; an empty
+// state stands for a device that is not registered.
+func countDevice(from, to DeviceState) {
+	if from == to {
+		return
+	}
+	if from != "" {
+		telemetry.Devices.WithLabelValues(string(from)).Dec()
+	}
+	if to != "" {
+		telemetry.Devices.WithLabelValues(string(to)).Inc()
+	}
+}
+
+func (r *Registry) sendRegistrationEvent(ctx context.Context, device *DeviceRegistration, eventType string) {
+	r.sendDeviceEvent(ctx, device, eventType, nil)
+}
+
+func (r *Registry) sendDeviceEvent(ctx context.Context, device *DeviceRegistration, eventType string, extra map[string]interface{}) {
 	eventData := map[string]interface{}{
 		"uid":           device.UID,
 		"foreign_key":   device.ForeignKey,
@@ -175,11 +450,18 @@ func (r *Registry) sendRegistrationEvent(device *DeviceRegistration, eventType s
 		"labels":        device.Labels,
 		"registered_at": device.RegisteredAt,
 		"last_seen":     device.LastSeen,
+		"state":         device.State,
 		"event_type":    eventType,
 	}
+	for k, v := range extra {
+		eventData[k] = v
+	}
+	if id := requestid.FromContext(ctx); id != "" {
+		eventData["request_id"] = id
+	}
 
-	if err := r.splunkClient.SendEvent(eventType, eventData); err != nil {
-		r.logger.Warn("Failed to send registration event to Splunk",
+	if err := r.sink.Write([]sink.Event{sink.NewEvent(eventType, eventData)}); err != nil {
+		r.logger.Warn("Failed to send device event",
 			zap.String("uid", device.UID),
 			zap.String("event_type", eventType),
 			zap.Error(err),
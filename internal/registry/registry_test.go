@@ -0,0 +1,191 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/sink"
+	"go.uber.org/zap"
+)
+
+type discardSink struct{}
+
+func (discardSink) Write([]sink.Event) error { return nil }
+func (discardSink) Flush() error             { return nil }
+func (discardSink) Close() error             { return nil }
+
+func newTestRegistry(t *testing.T) *Registry {
+	t.Helper()
+	r, err := NewRegistry(config.RegistryConfig{}, NewMemoryStore(), discardSink{}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	t.Cleanup(func() { r.Close() })
+	return r
+}
+
+// readDevice reads every field the API handlers serialize.
+func readDevice(d *DeviceRegistration) {
+	if _, err := json.Marshal(d); err != nil {
+		panic(err)
+	}
+}
+
+// TestRegistryConcurrentReads is meant for -race: devices returned by the
+// registry must not share memory with the ones heartbeats and
+// re-registrations update.
+func TestRegistryConcurrentReads(t *testing.T) {
+	r := newTestRegistry(t)
+	ctx := context.Background()
+
+	var uids []string
+	for i := 0; i < 4; i++ {
+		device, _, err := r.Register(ctx, RegistrationRequest{
+			ForeignKey: fmt.Sprintf("leaf%d", i),
+			Labels:     map[string]string{"rack": "r1"},
+		})
+		if err != nil {
+			t.Fatalf("Register: %v", err)
+		}
+		uids = append(uids, device.UID)
+	}
+
+	const rounds = 200
+	var wg sync.WaitGroup
+	run := func(f func(i int)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < rounds; i++ {
+				f(i)
+			}
+		}()
+	}
+
+	run(func(i int) {
+		r.UpdateLastSeen(ctx, uids[i%len(uids)])
+	})
+	run(func(i int) {
+		r.Register(ctx, RegistrationRequest{
+			ForeignKey: fmt.Sprintf("leaf%d", i%len(uids)),
+			Hostname:   fmt.Sprintf("host%d", i),
+			Labels:     map[string]string{"rack": fmt.Sprintf("r%d", i)},
+		})
+	})
+	run(func(i int) {
+		for _, d := range r.ListDevices() {
+			readDevice(d)
+		}
+	})
+	run(func(i int) {
+		devices, _, _, err := r.Query(DeviceQuery{Limit: 2})
+		if err != nil {
+			t.Errorf("Query: %v", err)
+			return
+		}
+		for _, d := range devices {
+			readDevice(d)
+		}
+	})
+	run(func(i int) {
+		if d, ok := r.GetByUID(uids[i%len(uids)]); ok {
+			readDevice(d)
+		}
+		if d, ok := r.GetByForeignKey(fmt.Sprintf("leaf%d", i%len(uids))); ok {
+			readDevice(d)
+		}
+	})
+
+	wg.Wait()
+}
+
+func TestRegistryReturnsCopies(t *testing.T) {
+	r := newTestRegistry(t)
+	ctx := context.Background()
+
+	labels := map[string]string{"rack": "r1"}
+	device, _, err := r.Register(ctx, RegistrationRequest{ForeignKey: "leaf1", Labels: labels})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	labels["rack"] = "changed by caller"
+	device.Labels["rack"] = "changed via result"
+	device.Hostname = "changed via result"
+
+	got, ok := r.GetByUID(device.UID)
+	if !ok {
+		t.Fatal("GetByUID: device not found")
+	}
+	if got.Labels["rack"] != "r1" || got.Hostname != "" {
+		t.Errorf("stored device changed through caller maps: labels %v, hostname %q", got.Labels, got.Hostname)
+	}
+}
+
+func TestCheckReregistration(t *testing.T) {
+	dir := t.TempDir()
+	fs, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	fs.Put(&DeviceRegistration{UID: "legacy", ForeignKey: "leaf0"})
+	fs.Close()
+
+	reopened, err := NewFileStore(dir, 100)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+	r, err := NewRegistry(config.RegistryConfig{}, reopened, discardSink{}, zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewRegistry: %v", err)
+	}
+	defer r.Close()
+
+	_, token, err := r.Register(context.Background(), RegistrationRequest{ForeignKey: "leaf1"})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+	_, other, err := r.Register(context.Background(), RegistrationRequest{ForeignKey: "leaf2"})
+	if err != nil {
+		t.Fatalf("Register: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		foreignKey string
+		token      string
+		want       error
+	}{
+		{"new device", "leaf9", "", nil},
+		{"current token", "leaf1", token, nil},
+		{"no token", "leaf1", "", ErrInvalidToken},
+		{"other device's token", "leaf1", other, ErrInvalidToken},
+		{"device without token", "leaf0", "", nil},
+		{"device without token, other device's token", "leaf0", other, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := r.CheckReregistration(tc.foreignKey, tc.token); err != tc.want {
+				t.Errorf("CheckReregistration = %v, want %v", err, tc.want)
+			}
+		})
+	}
+
+	// The first re-registration issues the device a token, which it then
+	// needs like any other device.
+	_, legacy, err := r.Register(context.Background(), RegistrationRequest{ForeignKey: "leaf0"})
+	if err != nil || legacy == "" {
+		t.Fatalf("Register = %q, %v", legacy, err)
+	}
+	if err := r.CheckReregistration("leaf0", ""); err != ErrInvalidToken {
+		t.Errorf("CheckReregistration without the issued token = %v, want %v", err, ErrInvalidToken)
+	}
+	if err := r.CheckReregistration("leaf0", legacy); err != nil {
+		t.Errorf("CheckReregistration with the issued token = %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+package registry
+
+import (
+	"fmt"
+	"strings"
+)
+
+type selectorOp int
+
+const (
+	opExists selectorOp = iota
+	opDoesNotExist
+	opEquals
+	opNotEquals
+	opIn
+	opNotIn
+)
+
+type requirement struct {
+	key    string
+	op     selectorOp
+	values []string
+}
+
+func (r requirement) matches(labels map[string]string) bool {
+	value, exists := labels[r.key]
+
+	switch r.op {
+	case opExists:
+		return exists
+	case opDoesNotExist:
+		return !exists
+	case opEquals, opIn:
+		return exists && r.has(value)
+	case opNotEquals, opNotIn:
+		return !exists || !r.has(value)
+	}
+	return false
+}
+
+func (r requirement) has(value string) bool {
+	for _, v := range r.values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Selector is a parsed Kubernetes-style label selector. Every requirement
+// must match for a device to be selected; the zero value selects everything.
+type Selector struct {
+	requirements []requirement
+}
+
+// Matches reports whether labels satisfy every requirement of the selector.
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s.requirements {
+		if !r.matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty reports whether the selector has no requirements.
+func (s Selector) Empty() bool {
+	return len(s.requirements) == 0
+}
+
+// ParseSelector parses a comma-separated list of label requirements:
+//
+//	key              label is present
+//	!key             label is absent
+//	key=value        label equals value (also key==value)
+//	key!=value       label is absent or differs from value
+//	key in (a,b)     label is one of the values
+//	key notin (a,b)  label is absent or none of the values
+func ParseSelector(expr string) (Selector, error) {
+	p := &selectorParser{tokens: lexSelector(expr)}
+
+	var sel Selector
+	if p.peek().kind == tokEOF {
+		return sel, nil
+	}
+
+	for {
+		r, err := p.requirement()
+		if err != nil {
+			return Selector{}, fmt.Errorf("invalid label selector %q: %w", expr, err)
+		}
+		sel.requirements = append(sel.requirements, r)
+
+		switch tok := p.next(); tok.kind {
+		case tokEOF:
+			return sel, nil
+		case tokComma:
+		default:
+			return Selector{}, fmt.Errorf("invalid label selector %q: expected ',' but found %s", expr, tok)
+		}
+	}
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokNot
+	tokEquals
+	tokNotEquals
+	tokOpen
+	tokClose
+	tokComma
+	tokInvalid
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of input"
+	case tokIdent:
+		return fmt.Sprintf("%q", t.value)
+	default:
+		return fmt.Sprintf("'%s'", t.value)
+	}
+}
+
+func isSelectorChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		c == '.' || c == '-' || c == '_' || c == '/'
+}
+
+func lexSelector(expr string) []token {
+	var tokens []token
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == ',':
+			tokens = append(tokens, token{tokComma, ","})
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokOpen, "("})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokClose, ")"})
+			i++
+		case strings.HasPrefix(expr[i:], "!="):
+			tokens = append(tokens, token{tokNotEquals, "!="})
+			i += 2
+		case c == '!':
+			tokens = append(tokens, token{tokNot, "!"})
+			i++
+		case strings.HasPrefix(expr[i:], "=="):
+			tokens = append(tokens, token{tokEquals, "=="})
+			i += 2
+		case c == '=':
+			tokens = append(tokens, token{tokEquals, "="})
+			i++
+		case isSelectorChar(c):
+			start := i
+			for i < len(expr) && isSelectorChar(expr[i]) {
+				i++
+			}
+			tokens = append(tokens, token{tokIdent, expr[start:i]})
+		default:
+			return append(tokens, token{tokInvalid, string(c)}, token{kind: tokEOF})
+		}
+	}
+	return append(tokens, token{kind: tokEOF})
+}
+
+type selectorParser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *selectorParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *selectorParser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *selectorParser) requirement() (requirement, error) {
+	if p.peek().kind == tokNot {
+		p.next()
+		key := p.next()
+		if key.kind != tokIdent {
+			return requirement{}, fmt.Errorf("expected label key after '!' but found %s", key)
+		}
+		return requirement{key: key.value, op: opDoesNotExist}, nil
+	}
+
+	key := p.next()
+	if key.kind != tokIdent {
+		return requirement{}, fmt.Errorf("expected label key but found %s", key)
+	}
+
+	switch tok := p.peek(); {
+	case tok.kind == tokEOF || tok.kind == tokComma:
+		return requirement{key: key.value, op: opExists}, nil
+	case tok.kind == tokEquals || tok.kind == tokNotEquals:
+		p.next()
+		op := opEquals
+		if tok.kind == tokNotEquals {
+			op = opNotEquals
+		}
+		value := ""
+		if p.peek().kind == tokIdent {
+			value = p.next().value
+		}
+		return requirement{key: key.value, op: op, values: []string{value}}, nil
+	case tok.kind == tokIdent && (tok.value == "in" || tok.value == "notin"):
+		p.next()
+		op := opIn
+		if tok.value == "notin" {
+			op = opNotIn
+		}
+		values, err := p.valueSet()
+		if err != nil {
+			return requirement{}, err
+		}
+		return requirement{key: key.value, op: op, values: values}, nil
+	default:
+		return requirement{}, fmt.Errorf("unexpected %s after label key %q", tok, key.value)
+	}
+}
+
+func (p *selectorParser) valueSet() ([]string, error) {
+	if tok := p.next(); tok.kind != tokOpen {
+		return nil, fmt.Errorf("expected '(' but found %s", tok)
+	}
+
+	var values []string
+	for {
+		tok := p.next()
+		if tok.kind != tokIdent {
+			return nil, fmt.Errorf("expected value but found %s", tok)
+		}
+		values = append(values, tok.value)
+
+		switch tok := p.next(); tok.kind {
+		case tokClose:
+			return values, nil
+		case tokComma:
+		default:
+			return nil, fmt.Errorf("expected ',' or ')' but found %s", tok)
+		}
+	}
+}
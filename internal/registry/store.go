@@ -0,0 +1,44 @@
+package registry
+
+import (
+	"fmt"
+
+	"github.com/vtapaskar/brahma/internal/config"
+)
+
+// Store persists device registrations so that UIDs stay stable across
+// restarts. Put, Delete and Replace are called with the registry lock held,
+// so implementations only need to be safe for a single writer.
+type Store interface {
+	Load() ([]*DeviceRegistration, error)
+	Put(device *DeviceRegistration) error
+	Delete(uid string) error
+	// Replace stores devices as the complete set of registrations at once.
+	Replace(devices []*DeviceRegistration) error
+	Close() error
+}
+
+func NewStore(cfg config.RegistryConfig) (Store, error) {
+	switch cfg.Backend {
+	case "", "memory":
+		return NewMemoryStore(), nil
+	case "file":
+		return NewFileStore(cfg.DataDir, cfg.CompactionThreshold)
+	default:
+		return nil, fmt.Errorf("unknown registry backend: %s", cfg.Backend)
+	}
+}
+
+type memoryStore struct{}
+
+// NewMemoryStore returns a Store that keeps nothing; the registry's own maps
+// are the only copy and are lost on restart.
+func NewMemoryStore() Store {
+	return memoryStore{}
+}
+
+func (memoryStore) Load() ([]*DeviceRegistration, error) { return nil, nil }
+func (memoryStore) Put(*DeviceRegistration) error        { return nil }
+func (memoryStore) Delete(string) error                  { return nil }
+func (memoryStore) Replace([]*DeviceRegistration) error  { return nil }
+func (memoryStore) Close() error                         { return nil }
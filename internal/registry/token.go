@@ -0,0 +1,159 @@
+package registry
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+var (
+	ErrDeviceNotFound = errors.New("device not found")
+	ErrInvalidToken   = errors.New("invalid device token")
+	ErrTokenRevoked   = errors.New("device token has been revoked")
+)
+
+// newToken returns a device token of the form "<uid>.<secret>" and the hash
+// stored in the registry. The secret itself is never persisted.
+func newToken(uid string) (string, string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("failed to generate device token: %w", err)
+	}
+
+	encoded := base64.RawURLEncoding.EncodeToString(secret)
+	return uid + "." + encoded, hashSecret(encoded), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueToken must be called with mu held. It updates device in place; the
+// caller persists it.
+func (r *Registry) issueToken(device *DeviceRegistration) (string, error) {
+	token, hash, err := newToken(device.UID)
+	if err != nil {
+		return "", err
+	}
+
+	device.TokenHash = hash
+	device.TokenIssuedAt = time.Now()
+	device.TokenRevoked = false
+	return token, nil
+}
+
+// AuthenticateToken returns the device a token was issued to.
+func (r *Registry) AuthenticateToken(token string) (*DeviceRegistration, error) {
+	uid, secret, ok := strings.Cut(token, ".")
+	if !ok || uid == "" || secret == "" {
+		return nil, ErrInvalidToken
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	device, exists := r.devices[uid]
+	if !exists {
+		return nil, ErrInvalidToken
+	}
+	if device.TokenRevoked {
+		return nil, ErrTokenRevoked
+	}
+	if device.TokenHash == "" || subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(device.TokenHash)) != 1 {
+		return nil, ErrInvalidToken
+	}
+
+	return device.clone(), nil
+}
+
+// CheckReregistration decides whether a caller presenting token may register
+// foreignKey again, which replaces the device's token. New devices are always
+// allowed; otherwise the caller must hold the device's current token. A
+// device registered before tokens existed has none to present, so its first
+// re-registration is trusted and issues it one.
+func (r *Registry) CheckReregistration(foreignKey, token string) error {
+	r.mu.RLock()
+	uid, exists := r.byForeignKey[foreignKey]
+	var device DeviceRegistration
+	if exists {
+		device = *r.devices[uid]
+	}
+	r.mu.RUnlock()
+
+	switch {
+	case !exists:
+		return nil
+	case device.TokenRevoked:
+		return ErrTokenRevoked
+	case device.TokenHash == "":
+		return nil
+	}
+
+	authenticated, err := r.AuthenticateToken(token)
+	if err != nil {
+		return err
+	}
+	if authenticated.UID != device.UID {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// RotateToken issues a new token for uid, invalidating the previous one and
+// lifting any revocation.
+func (r *Registry) RotateToken(uid string) (string, *DeviceRegistration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	device, exists := r.devices[uid]
+	if !exists {
+		return "", nil, ErrDeviceNotFound
+	}
+
+	updated := *device
+	token, err := r.issueToken(&updated)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := r.store.Put(&updated); err != nil {
+		return "", nil, fmt.Errorf("failed to persist device: %w", err)
+	}
+	*device = updated
+
+	r.logger.Info("Device token rotated", zap.String("uid", uid))
+
+	return token, device.clone(), nil
+}
+
+// RevokeToken invalidates the device's token. The device cannot call the
+// API, or register itself again, until an administrator rotates the token.
+func (r *Registry) RevokeToken(uid string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	device, exists := r.devices[uid]
+	if !exists {
+		return ErrDeviceNotFound
+	}
+
+	updated := *device
+	updated.TokenHash = ""
+	updated.TokenRevoked = true
+	if err := r.store.Put(&updated); err != nil {
+		return fmt.Errorf("failed to persist device: %w", err)
+	}
+	*device = updated
+
+	r.logger.Warn("Device token revoked", zap.String("uid", uid))
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type ChangeType string
+
+const (
+	ChangeAdded    ChangeType = "ADDED"
+	ChangeModified ChangeType = "MODIFIED"
+	ChangeDeleted  ChangeType = "DELETED"
+)
+
+const (
+	defaultWatchHistory = 1000
+	watcherBuffer       = 256
+)
+
+var (
+	// ErrRevisionCompacted is returned by Watch when the requested revision
+	// is older than the change log; the caller has to start from a snapshot.
+	ErrRevisionCompacted = errors.New("revision is no longer available")
+	// ErrWatcherLagged ends a watch whose consumer did not keep up. The caller
+	// can resume from the last revision it received.
+	ErrWatcherLagged  = errors.New("watcher fell behind")
+	ErrRegistryClosed = errors.New("registry is closed")
+)
+
+// Change is one entry of the registry change log. Previous is set for
+// modifications so that watchers filtering on labels can tell when a device
+// starts or stops matching.
+type Change struct {
+	Type     ChangeType
+	Revision uint64
+	Device   DeviceRegistration
+	Previous *DeviceRegistration
+}
+
+// Watcher receives registry changes in revision order until it is closed,
+// either by the caller or because it fell behind. Snapshot or Backlog holds
+// the state the watch started from, as of Revision; Events delivers every
+// change after that.
+type Watcher struct {
+	Snapshot []DeviceRegistration
+	Backlog  []Change
+	Revision uint64
+
+	registry *Registry
+	events   chan Change
+	once     sync.Once
+	err      error
+}
+
+func (w *Watcher) Events() <-chan Change {
+	return w.events
+}
+
+// Err returns why the event channel was closed, or nil after Close.
+func (w *Watcher) Err() error {
+	w.registry.mu.RLock()
+	defer w.registry.mu.RUnlock()
+	return w.err
+}
+
+func (w *Watcher) Close() {
+	w.registry.mu.Lock()
+	defer w.registry.mu.Unlock()
+	w.registry.removeWatcher(w, nil)
+}
+
+// initialRevision seeds the revision counter from the clock, so revisions
+// keep increasing across restarts and a client resuming with a revision from
+// a previous process is told to take a fresh snapshot instead of silently
+// missing changes.
+func initialRevision() uint64 {
+	return uint64(time.Now().UnixNano())
+}
+
+// record must be called with mu held.
+func (r *Registry) record(changeType ChangeType, device *DeviceRegistration, previous *DeviceRegistration) {
+	r.revision++
+
+	change := Change{
+		Type:     changeType,
+		Revision: r.revision,
+		Device:   *device,
+	}
+	if previous != nil {
+		prev := *previous
+		change.Previous = &prev
+	}
+
+	r.changes = append(r.changes, change)
+	if len(r.changes) > r.watchHistory {
+		r.changes = append(r.changes[:0], r.changes[len(r.changes)-r.watchHistory:]...)
+	}
+
+	for w := range r.watchers {
+		select {
+		case w.events <- change:
+		default:
+			r.removeWatcher(w, fmt.Errorf("%w at revision %d", ErrWatcherLagged, r.revision-1))
+		}
+	}
+}
+
+// removeWatcher must be called with mu held.
+func (r *Registry) removeWatcher(w *Watcher, err error) {
+	w.once.Do(func() {
+		delete(r.watchers, w)
+		w.err = err
+		close(w.events)
+	})
+}
+
+// Watch subscribes to registry changes. With fromRevision zero the watcher
+// starts with a snapshot of every device; otherwise it starts with the
+// changes after fromRevision from the change log. Either way its events
+// continue from there with no gap or overlap.
+func (r *Registry) Watch(fromRevision uint64) (*Watcher, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.closed {
+		return nil, ErrRegistryClosed
+	}
+
+	w := &Watcher{
+		Revision: r.revision,
+		registry: r,
+		events:   make(chan Change, watcherBuffer),
+	}
+
+	if fromRevision == 0 {
+		w.Snapshot = make([]DeviceRegistration, 0, len(r.devices))
+		for _, device := range r.devices {
+			w.Snapshot = append(w.Snapshot, *device)
+		}
+	} else {
+		oldest := r.revision
+		if len(r.changes) > 0 {
+			oldest = r.changes[0].Revision - 1
+		}
+		if fromRevision < oldest || fromRevision > r.revision {
+			return nil, ErrRevisionCompacted
+		}
+		for _, change := range r.changes {
+			if change.Revision > fromRevision {
+				w.Backlog = append(w.Backlog, change)
+			}
+		}
+	}
+
+	r.watchers[w] = struct{}{}
+	return w, nil
+}
@@ -0,0 +1,45 @@
+// Package requestid carries the ID of the API request being served, so that
+// logs and Splunk events produced while serving it can be correlated.
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Header is the gRPC metadata key and HTTP header the ID is read from and
+// returned in.
+const Header = "x-request-id"
+
+const maxLength = 128
+
+type contextKey struct{}
+
+func New() string {
+	return uuid.New().String()
+}
+
+// Sanitize returns id if it is usable as a request ID supplied by a client,
+// or a new ID otherwise.
+func Sanitize(id string) string {
+	if id == "" || len(id) > maxLength {
+		return New()
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return New()
+		}
+	}
+	return id
+}
+
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns the request ID stored in ctx, or "" if there is none.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	return id
+}
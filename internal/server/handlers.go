@@ -0,0 +1,296 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+
+	"github.com/vtapaskar/brahma/internal/metrics"
+	"github.com/vtapaskar/brahma/internal/registry"
+	"github.com/vtapaskar/brahma/internal/storage"
+	"go.uber.org/zap"
+)
+
+const maxMetricsBodySize = 4 * 1024 * 1024
+
+type metricsRequest struct {
+	UID        string          `json:"uid"`
+	DeviceID   string          `json:"device_id"`
+	MetricType string          `json:"metric_type"`
+	Data       json.RawMessage `json:"data"`
+}
+
+func (s *Server) handleRegister(w http.ResponseWriter, r *http.Request) {
+	var req registry.RegistrationRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxMetricsBodySize)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	if req.ForeignKey == "" {
+		writeError(w, http.StatusBadRequest, "foreign_key is required")
+		return
+	}
+
+	// Registering again replaces the device's token, so only the device
+	// itself, or the administrator, may do it.
+	if token := bearerToken(r); !s.isAdminToken(token) {
+		if err := s.registry.CheckReregistration(req.ForeignKey, token); err != nil {
+			writeError(w, http.StatusForbidden, fmt.Sprintf("device is already registered: %v", err))
+			return
+		}
+	}
+
+	device, token, err := s.registry.Register(r.Context(), req)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to register device: %v", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"uid":           device.UID,
+		"foreign_key":   device.ForeignKey,
+		"status":        "registered",
+		"registered_at": device.RegisteredAt,
+		"token":         token,
+	})
+}
+
+func (s *Server) handleMetrics(w http.ResponseWriter, r *http.Request) {
+	var req metricsRequest
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxMetricsBodySize)).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON body")
+		return
+	}
+
+	uid, ok := s.resolveDevice(req.UID, req.DeviceID)
+	if !ok {
+		writeError(w, http.StatusNotFound, "device not registered")
+		return
+	}
+	if !s.authorizeDevice(w, r, uid) {
+		return
+	}
+
+	if len(req.Data) == 0 {
+		writeError(w, http.StatusBadRequest, "data is required")
+		return
+	}
+
+	var err error
+	switch req.MetricType {
+	case "cpu_stats":
+		var stats metrics.CPUStats
+		if err = json.Unmarshal(req.Data, &stats); err == nil {
+			stats.UID = uid
+			err = s.collector.CollectCPUStats(r.Context(), &stats)
+		}
+	case "process_stats":
+		var stats metrics.ProcessStats
+		if err = json.Unmarshal(req.Data, &stats); err == nil {
+			stats.UID = uid
+			err = s.collector.CollectProcessStats(r.Context(), &stats)
+		}
+	case "mgmt_network_stats":
+		var stats metrics.MgmtNetworkStats
+		if err = json.Unmarshal(req.Data, &stats); err == nil {
+			stats.UID = uid
+			err = s.collector.CollectMgmtNetworkStats(r.Context(), &stats)
+		}
+	case "router_base_state":
+		var state metrics.RouterBaseState
+		if err = json.Unmarshal(req.Data, &state); err == nil {
+			state.UID = uid
+			err = s.collector.CollectRouterBaseState(r.Context(), &state)
+		}
+	case "interface_stats":
+		var stats metrics.InterfaceStats
+		if err = json.Unmarshal(req.Data, &stats); err == nil {
+			stats.UID = uid
+			err = s.collector.CollectInterfaceStats(r.Context(), &stats)
+		}
+	case "bgp_neighbors":
+		var stats metrics.BGPNeighborStats
+		if err = json.Unmarshal(req.Data, &stats); err == nil {
+			stats.UID = uid
+			err = s.collector.CollectBGPNeighborStats(r.Context(), &stats)
+		}
+	case "vxlan_tunnels":
+		var stats metrics.VXLANTunnelStats
+		if err = json.Unmarshal(req.Data, &stats); err == nil {
+			stats.UID = uid
+			err = s.collector.CollectVXLANTunnelStats(r.Context(), &stats)
+		}
+	case "qos_queues":
+		var stats metrics.QoSQueueStats
+		if err = json.Unmarshal(req.Data, &stats); err == nil {
+			stats.UID = uid
+			err = s.collector.CollectQoSQueueStats(r.Context(), &stats)
+		}
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported metric_type: %q", req.MetricType))
+		return
+	}
+
+	if _, ok := err.(*json.UnmarshalTypeError); ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s data: %v", req.MetricType, err))
+		return
+	}
+	if errors.Is(err, metrics.ErrQueueFull) {
+		retryAfter := int(s.collector.RetryDelay().Seconds())
+		if retryAfter < 1 {
+			retryAfter = 1
+		}
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		writeError(w, http.StatusTooManyRequests, "metrics queue is full, retry later")
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to collect %s: %v", req.MetricType, err))
+		return
+	}
+
+	s.registry.UpdateLastSeen(r.Context(), uid)
+
+	writeJSON(w, http.StatusAccepted, map[string]string{
+		"status": "accepted",
+		"uid":    uid,
+	})
+}
+
+func (s *Server) handleCrashReport(w http.ResponseWriter, r *http.Request) {
+	s.handleLogUpload(w, r, "crash")
+}
+
+func (s *Server) handleBacktrace(w http.ResponseWriter, r *http.Request) {
+	s.handleLogUpload(w, r, "backtrace")
+}
+
+func (s *Server) handleTechSupport(w http.ResponseWriter, r *http.Request) {
+	s.handleLogUpload(w, r, "techsupport")
+}
+
+// handleLogUpload streams the "file" part of a multipart form straight into
+// S3. The device and descriptive fields must come before the file part so
+// that the upload can be started without buffering the file.
+func (s *Server) handleLogUpload(w http.ResponseWriter, r *http.Request, logType string) {
+	reader, err := r.MultipartReader()
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "expected multipart/form-data body")
+		return
+	}
+
+	fields := make(map[string]string)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			writeError(w, http.StatusBadRequest, "file is required")
+			return
+		}
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed multipart body: %v", err))
+			return
+		}
+
+		if part.FormName() != "file" {
+			value, err := io.ReadAll(io.LimitReader(part, 4096))
+			if err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("malformed multipart body: %v", err))
+				return
+			}
+			fields[part.FormName()] = string(value)
+			continue
+		}
+
+		s.storeLogPart(w, r, logType, fields, part)
+		return
+	}
+}
+
+func (s *Server) storeLogPart(w http.ResponseWriter, r *http.Request, logType string, fields map[string]string, part *multipart.Part) {
+	uid, ok := s.resolveDevice(fields["uid"], fields["device_id"])
+	if !ok {
+		writeError(w, http.StatusNotFound, "device not registered")
+		return
+	}
+	if !s.authorizeDevice(w, r, uid) {
+		return
+	}
+
+	filename := fields["filename"]
+	if filename == "" {
+		filename = part.FileName()
+	}
+
+	report := &metrics.LogReport{
+		DeviceUID:      uid,
+		ProcessTag:     fields["process_tag"],
+		Version:        fields["version"],
+		Filename:       filename,
+		ExpectedSHA256: fields["sha256"],
+	}
+	if size := fields["size"]; size != "" {
+		n, err := strconv.ParseInt(size, 10, 64)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "size must be an integer")
+			return
+		}
+		report.ExpectedSize = n
+	}
+	if err := report.Validate(); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var upload *metrics.LogUpload
+	switch logType {
+	case "backtrace":
+		upload = s.collector.StartBacktrace(r.Context(), report)
+	case "techsupport":
+		upload = s.collector.StartTechSupport(r.Context(), report)
+	default:
+		upload = s.collector.StartCrashReport(r.Context(), report)
+	}
+
+	if _, err := io.Copy(upload, part); err != nil {
+		upload.Abort()
+		if errors.Is(err, storage.ErrObjectTooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+			return
+		}
+		s.logger.Warn("HTTP log upload failed",
+			zap.String("device_uid", uid),
+			zap.String("log_type", logType),
+			zap.Error(err),
+		)
+		writeError(w, http.StatusBadGateway, fmt.Sprintf("failed to store upload: %v", err))
+		return
+	}
+
+	logID, err := upload.Commit()
+	if errors.Is(err, metrics.ErrIntegrity) {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if errors.Is(err, storage.ErrObjectTooLarge) {
+		writeError(w, http.StatusRequestEntityTooLarge, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("failed to store %s: %v", logType, err))
+		return
+	}
+
+	s.registry.UpdateLastSeen(r.Context(), uid)
+
+	writeJSON(w, http.StatusCreated, map[string]string{
+		"status": "created",
+		"log_id": logID,
+		"uid":    uid,
+		"s3_key": report.S3Key,
+	})
+}
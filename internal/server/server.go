@@ -0,0 +1,186 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/health"
+	"github.com/vtapaskar/brahma/internal/metrics"
+	"github.com/vtapaskar/brahma/internal/registry"
+	"github.com/vtapaskar/brahma/internal/requestid"
+	"github.com/vtapaskar/brahma/internal/telemetry"
+	"go.uber.org/zap"
+)
+
+// Server exposes the HTTP/JSON ingestion API for devices that cannot speak
+// gRPC, next to the health probes and Prometheus endpoints. It feeds the same
+// collector and registry as the gRPC server.
+type Server struct {
+	config    config.ServerConfig
+	auth      config.GRPCConfig
+	collector *metrics.Collector
+	registry  *registry.Registry
+	checker   *health.Checker
+	exporter  *metrics.DeviceExporter
+	logger    *zap.Logger
+	server    *http.Server
+}
+
+// NewServer takes the gRPC settings for device authentication, which both
+// APIs share.
+func NewServer(cfg config.ServerConfig, auth config.GRPCConfig, collector *metrics.Collector, reg *registry.Registry, checker *health.Checker, exporter *metrics.DeviceExporter, logger *zap.Logger) *Server {
+	s := &Server{
+		config:    cfg,
+		auth:      auth,
+		collector: collector,
+		registry:  reg,
+		checker:   checker,
+		exporter:  exporter,
+		logger:    logger,
+	}
+
+	router := mux.NewRouter()
+	router.Use(requestIDMiddleware)
+	router.HandleFunc("/health", s.handleHealth).Methods(http.MethodGet)
+	router.HandleFunc("/ready", s.handleReady).Methods(http.MethodGet)
+	router.Handle("/metrics", telemetry.Handler()).Methods(http.MethodGet)
+	router.Handle("/metrics/devices", exporter.Handler()).Methods(http.MethodGet)
+
+	// Plain HTTP never sees a client certificate, so with mTLS the device API
+	// would let devices bypass the certificate binding gRPC enforces.
+	if auth.ClientCAFile == "" {
+		api := router.PathPrefix("/api/v1").Subrouter()
+		api.HandleFunc("/devices", s.handleRegister).Methods(http.MethodPost)
+		api.HandleFunc("/metrics", s.handleMetrics).Methods(http.MethodPost)
+		api.HandleFunc("/crash-report", s.handleCrashReport).Methods(http.MethodPost)
+		api.HandleFunc("/backtrace", s.handleBacktrace).Methods(http.MethodPost)
+		api.HandleFunc("/techsupport", s.handleTechSupport).Methods(http.MethodPost)
+	} else {
+		logger.Info("HTTP device API disabled because gRPC requires client certificates")
+	}
+
+	s.server = &http.Server{
+		Addr:              fmt.Sprintf("%s:%d", cfg.Address, cfg.Port),
+		Handler:           router,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	return s
+}
+
+func (s *Server) Start() error {
+	s.logger.Info("HTTP server starting", zap.String("address", s.server.Addr))
+
+	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) Stop() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := s.server.Shutdown(ctx); err != nil {
+		s.logger.Warn("HTTP server shutdown did not complete", zap.Error(err))
+	}
+}
+
+// requestIDMiddleware gives every request an ID, taken from the caller's
+// X-Request-ID header if present, which is echoed back and attached to the
+// Splunk events the request produces.
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := requestid.Sanitize(r.Header.Get(requestid.Header))
+		w.Header().Set(requestid.Header, id)
+		next.ServeHTTP(w, r.WithContext(requestid.NewContext(r.Context(), id)))
+	})
+}
+
+// handleHealth is the liveness check: it only tells that the process is up.
+func (s *Server) handleHealth(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleReady reports whether every dependency check passed, so a load
+// balancer can stop sending traffic while one is down.
+func (s *Server) handleReady(w http.ResponseWriter, r *http.Request) {
+	status, code := "ready", http.StatusOK
+	if !s.checker.Healthy(health.CheckSplunk, health.CheckS3, health.CheckQueue) {
+		status, code = "not ready", http.StatusServiceUnavailable
+	}
+
+	writeJSON(w, code, map[string]interface{}{
+		"status": status,
+		"checks": s.checker.Results(),
+	})
+}
+
+// resolveDevice accepts either the Brahma UID or the device's foreign key
+// (the README's device_id) and returns the registered UID.
+func (s *Server) resolveDevice(uid, deviceID string) (string, bool) {
+	if uid != "" {
+		_, exists := s.registry.GetByUID(uid)
+		return uid, exists
+	}
+	if deviceID != "" {
+		if device, exists := s.registry.GetByForeignKey(deviceID); exists {
+			return device.UID, true
+		}
+	}
+	return "", false
+}
+
+func bearerToken(r *http.Request) string {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func (s *Server) isAdminToken(token string) bool {
+	return s.auth.AdminToken != "" && token != "" &&
+		subtle.ConstantTimeCompare([]byte(token), []byte(s.auth.AdminToken)) == 1
+}
+
+// authorizeDevice checks that the request carries the token issued to uid,
+// writing a 401 or 403 response if it does not.
+func (s *Server) authorizeDevice(w http.ResponseWriter, r *http.Request, uid string) bool {
+	token := bearerToken(r)
+	if token == "" {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, "bearer token required")
+		return false
+	}
+
+	device, err := s.registry.AuthenticateToken(token)
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeError(w, http.StatusUnauthorized, err.Error())
+		return false
+	}
+	if device.UID != uid {
+		writeError(w, http.StatusForbidden, fmt.Sprintf("token is not valid for device %q", uid))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]string{"error": message})
+}
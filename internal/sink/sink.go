@@ -0,0 +1,116 @@
+// Package sink delivers the events Brahma produces (metrics, log metadata and
+// device events) to downstream systems. A Router fans each event out to the
+// sinks configured for its type.
+package sink
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	Splunk = "splunk"
+	File   = "file"
+)
+
+// DefaultRoute applies to event types without a route of their own.
+const DefaultRoute = "*"
+
+// Event is one metric, log metadata record or device event. Type is the
+// Splunk event_type, e.g. "cpu_stats" or "device_registered".
+type Event struct {
+	Type string                 `json:"event_type"`
+	Time time.Time              `json:"time"`
+	Data map[string]interface{} `json:"data"`
+}
+
+func NewEvent(eventType string, data map[string]interface{}) Event {
+	return Event{Type: eventType, Time: time.Now(), Data: data}
+}
+
+// Sink is a destination for events. Write may deliver or buffer a batch;
+// Flush pushes out anything buffered, and Close flushes and releases the
+// sink.
+type Sink interface {
+	Write(events []Event) error
+	Flush() error
+	Close() error
+}
+
+// Router is a Sink that sends every event to the sinks routed for its type,
+// or to the DefaultRoute ones. An event type routed to no sinks is dropped.
+type Router struct {
+	sinks  map[string]Sink
+	routes map[string][]string
+}
+
+func NewRouter(sinks map[string]Sink, routes map[string][]string) (*Router, error) {
+	for eventType, names := range routes {
+		for _, name := range names {
+			if _, ok := sinks[name]; !ok {
+				return nil, fmt.Errorf("route %q uses unknown sink %q", eventType, name)
+			}
+		}
+	}
+
+	return &Router{sinks: sinks, routes: routes}, nil
+}
+
+func (r *Router) route(eventType string) []string {
+	if names, ok := r.routes[eventType]; ok {
+		return names
+	}
+	return r.routes[DefaultRoute]
+}
+
+// Write hands each sink its share of events as one batch. A failing sink
+// does not keep the others from receiving theirs.
+func (r *Router) Write(events []Event) error {
+	batches := make(map[string][]Event)
+	for _, event := range events {
+		for _, name := range r.route(event.Type) {
+			batches[name] = append(batches[name], event)
+		}
+	}
+
+	var errs []error
+	for _, name := range r.names() {
+		if batch := batches[name]; len(batch) > 0 {
+			if err := r.sinks[name].Write(batch); err != nil {
+				errs = append(errs, fmt.Errorf("%s sink: %w", name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Router) Flush() error {
+	var errs []error
+	for _, name := range r.names() {
+		if err := r.sinks[name].Flush(); err != nil {
+			errs = append(errs, fmt.Errorf("%s sink: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Router) Close() error {
+	var errs []error
+	for _, name := range r.names() {
+		if err := r.sinks[name].Close(); err != nil {
+			errs = append(errs, fmt.Errorf("%s sink: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Router) names() []string {
+	names := make([]string, 0, len(r.sinks))
+	for name := range r.sinks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
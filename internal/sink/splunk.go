@@ -0,0 +1,97 @@
+package sink
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/vtapaskar/brahma/internal/splunk"
+	"github.com/vtapaskar/brahma/internal/spool"
+	"go.uber.org/zap"
+)
+
+// SplunkSink sends events to Splunk HEC. Events that fail for a retryable
+// reason are appended to the spool, when there is one, for later replay.
+type SplunkSink struct {
+	client *splunk.Client
+	spool  *spool.Spool
+	logger *zap.Logger
+}
+
+func NewSplunkSink(client *splunk.Client, sp *spool.Spool, logger *zap.Logger) *SplunkSink {
+	return &SplunkSink{
+		client: client,
+		spool:  sp,
+		logger: logger,
+	}
+}
+
+// Write returns an error only for events that were neither delivered nor
+// spooled.
+func (s *SplunkSink) Write(events []Event) error {
+	batch := make([]splunk.Event, 0, len(events))
+	for _, event := range events {
+		// Events may be shared with other sinks, and NewEvent adds the
+		// event type to the data it is given.
+		data := make(map[string]interface{}, len(event.Data)+1)
+		for k, v := range event.Data {
+			data[k] = v
+		}
+
+		hecEvent := s.client.NewEvent(event.Type, data)
+		if !event.Time.IsZero() {
+			hecEvent.Time = event.Time.Unix()
+		}
+		batch = append(batch, hecEvent)
+	}
+
+	err := s.client.SendEvents(batch)
+
+	var batchErr *splunk.BatchError
+	if !errors.As(err, &batchErr) {
+		return err
+	}
+
+	var retry []splunk.Event
+	var dropped int
+	for _, failed := range batchErr.Failed {
+		if failed.Retryable && s.spool != nil {
+			retry = append(retry, failed.Event)
+			continue
+		}
+		dropped++
+		s.logger.Error("Failed to send event to Splunk",
+			zap.Any("uid", failed.Event.Event["uid"]),
+			zap.Any("event_type", failed.Event.Event["event_type"]),
+			zap.Error(failed.Err),
+		)
+	}
+
+	if len(retry) > 0 {
+		if err := s.spool.Append(retry); err != nil {
+			s.logger.Error("Failed to spool undelivered events, dropping them",
+				zap.Int("count", len(retry)),
+				zap.Error(err),
+			)
+			dropped += len(retry)
+		} else {
+			s.logger.Warn("Splunk unavailable, spooled events to disk",
+				zap.Int("count", len(retry)),
+				zap.Error(batchErr.Failed[0].Err),
+			)
+		}
+	}
+
+	if dropped > 0 {
+		return fmt.Errorf("%d of %d events dropped: %w", dropped, len(events), batchErr)
+	}
+	return nil
+}
+
+// Flush is a no-op: events are sent as they are written.
+func (s *SplunkSink) Flush() error {
+	return nil
+}
+
+func (s *SplunkSink) Close() error {
+	return nil
+}
@@ -2,16 +2,25 @@ package splunk
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/telemetry"
 	"go.uber.org/zap"
 )
 
+const (
+	defaultBatchMaxEvents = 100
+	defaultBatchMaxBytes  = 1024 * 1024
+)
+
 type Client struct {
 	config     config.SplunkConfig
 	httpClient *http.Client
@@ -27,6 +36,37 @@ type Event struct {
 	Event      map[string]interface{} `json:"event"`
 }
 
+// EventError identifies one event of a batch that Splunk did not accept.
+// Retryable is false when the event itself was at fault (it could not be
+// marshaled or HEC rejected it as invalid) and resending it cannot succeed.
+type EventError struct {
+	Index     int
+	Event     Event
+	Err       error
+	Retryable bool
+}
+
+// BatchError is returned by SendEvents when some or all events could not be
+// delivered. Failed lists every undelivered event by its index in the slice
+// that was passed in.
+type BatchError struct {
+	Failed []EventError
+	Total  int
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Failed) == 0 {
+		return "splunk batch failed"
+	}
+	return fmt.Sprintf("%d of %d events not delivered to splunk: %v", len(e.Failed), e.Total, e.Failed[0].Err)
+}
+
+type hecResponse struct {
+	Text               string `json:"text"`
+	Code               int    `json:"code"`
+	InvalidEventNumber *int   `json:"invalid-event-number"`
+}
+
 func NewClient(cfg config.SplunkConfig, logger *zap.Logger) *Client {
 	transport := &http.Transport{
 		TLSClientConfig: &tls.Config{
@@ -34,6 +74,13 @@ func NewClient(cfg config.SplunkConfig, logger *zap.Logger) *Client {
 		},
 	}
 
+	if cfg.BatchMaxEvents <= 0 {
+		cfg.BatchMaxEvents = defaultBatchMaxEvents
+	}
+	if cfg.BatchMaxBytes <= 0 {
+		cfg.BatchMaxBytes = defaultBatchMaxBytes
+	}
+
 	return &Client{
 		config: cfg,
 		httpClient: &http.Client{
@@ -44,85 +91,150 @@ func NewClient(cfg config.SplunkConfig, logger *zap.Logger) *Client {
 	}
 }
 
-func (c *Client) SendEvent(eventType string, data map[string]interface{}) error {
+// NewEvent wraps data in a HEC event addressed to the configured index,
+// source and sourcetype.
+func (c *Client) NewEvent(eventType string, data map[string]interface{}) Event {
 	data["event_type"] = eventType
 
-	event := Event{
+	return Event{
 		Time:       time.Now().Unix(),
 		Source:     c.config.Source,
 		SourceType: c.config.SourceType,
 		Index:      c.config.Index,
 		Event:      data,
 	}
+}
 
-	payload, err := json.Marshal(event)
-	if err != nil {
-		return fmt.Errorf("failed to marshal event: %w", err)
+// SendEvents delivers events in as many HEC requests as needed to keep each
+// request within the configured batch_max_events and batch_max_bytes.
+func (c *Client) SendEvents(events []Event) error {
+	var buffer bytes.Buffer
+	var indexes []int
+	var failed []EventError
+
+	for i, event := range events {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			telemetry.SplunkEvents.WithLabelValues("failed").Inc()
+			failed = append(failed, EventError{Index: i, Event: event, Err: fmt.Errorf("failed to marshal event: %w", err)})
+			continue
+		}
+
+		full := len(indexes) >= c.config.BatchMaxEvents ||
+			buffer.Len()+len(payload)+1 > c.config.BatchMaxBytes
+		if len(indexes) > 0 && full {
+			failed = append(failed, c.postBatch(buffer.Bytes(), events, indexes)...)
+			buffer.Reset()
+			indexes = indexes[:0]
+		}
+
+		buffer.Write(payload)
+		buffer.WriteByte('\n')
+		indexes = append(indexes, i)
 	}
 
-	scheme := "http"
-	if c.config.UseTLS {
-		scheme = "https"
+	if len(indexes) > 0 {
+		failed = append(failed, c.postBatch(buffer.Bytes(), events, indexes)...)
+	}
+
+	if len(failed) > 0 {
+		return &BatchError{Failed: failed, Total: len(events)}
+	}
+	return nil
+}
+
+// postBatch sends one newline-delimited payload and maps a failure back onto
+// the events it contained. When HEC names the offending event, it has indexed
+// the events before it and none after it: the offending event is reported as
+// rejected and the ones after it as retryable. Otherwise the whole request is
+// retryable.
+func (c *Client) postBatch(payload []byte, events []Event, indexes []int) (failed []EventError) {
+	defer func() {
+		telemetry.SplunkEvents.WithLabelValues("sent").Add(float64(len(indexes) - len(failed)))
+		telemetry.SplunkEvents.WithLabelValues("failed").Add(float64(len(failed)))
+	}()
+
+	resp, err := c.post(payload)
+	if err == nil {
+		return nil
 	}
-	url := fmt.Sprintf("%s://%s:%d/services/collector/event", scheme, c.config.Host, c.config.Port)
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(payload))
+	if resp != nil && resp.InvalidEventNumber != nil {
+		n := *resp.InvalidEventNumber
+		if n >= 0 && n < len(indexes) {
+			failed = []EventError{{Index: indexes[n], Event: events[indexes[n]], Err: err, Retryable: false}}
+			skipped := fmt.Errorf("not indexed after invalid event %d of the request: %w", n, err)
+			for _, i := range indexes[n+1:] {
+				failed = append(failed, EventError{Index: i, Event: events[i], Err: skipped, Retryable: true})
+			}
+			return failed
+		}
+	}
+
+	failed = make([]EventError, 0, len(indexes))
+	for _, i := range indexes {
+		failed = append(failed, EventError{Index: i, Event: events[i], Err: err, Retryable: true})
+	}
+	return failed
+}
+
+func (c *Client) post(payload []byte) (*hecResponse, error) {
+	req, err := http.NewRequest("POST", c.endpoint("/services/collector/event"), bytes.NewReader(payload))
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	req.Header.Set("Authorization", "Splunk "+c.config.Token)
 	req.Header.Set("Content-Type", "application/json")
 
+	start := time.Now()
+	defer func() { telemetry.SplunkRequestDuration.Observe(telemetry.Since(start)) }()
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send request: %w", err)
+		return nil, fmt.Errorf("failed to send request: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("splunk returned non-OK status: %d", resp.StatusCode)
+	if resp.StatusCode == http.StatusOK {
+		io.Copy(io.Discard, resp.Body)
+		return nil, nil
 	}
 
-	return nil
-}
-
-func (c *Client) SendBatch(events []Event) error {
-	var buffer bytes.Buffer
-
-	for _, event := range events {
-		payload, err := json.Marshal(event)
-		if err != nil {
-			c.logger.Warn("Failed to marshal event in batch", zap.Error(err))
-			continue
-		}
-		buffer.Write(payload)
-		buffer.WriteByte('\n')
+	var hec hecResponse
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if json.Unmarshal(body, &hec) != nil || hec.Text == "" {
+		return nil, fmt.Errorf("splunk returned non-OK status: %d", resp.StatusCode)
 	}
 
-	scheme := "http"
-	if c.config.UseTLS {
-		scheme = "https"
-	}
-	url := fmt.Sprintf("%s://%s:%d/services/collector/event", scheme, c.config.Host, c.config.Port)
+	return &hec, fmt.Errorf("splunk returned non-OK status: %d: %s", resp.StatusCode, strings.TrimSpace(hec.Text))
+}
 
-	req, err := http.NewRequest("POST", url, &buffer)
+// Health probes the HEC health endpoint, which reports whether HEC is
+// accepting events.
+func (c *Client) Health(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.endpoint("/services/collector/health"), nil)
 	if err != nil {
-		return fmt.Errorf("failed to create batch request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", "Splunk "+c.config.Token)
-	req.Header.Set("Content-Type", "application/json")
-
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send batch request: %w", err)
+		return fmt.Errorf("failed to reach splunk: %w", err)
 	}
 	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("splunk returned non-OK status for batch: %d", resp.StatusCode)
+		return fmt.Errorf("splunk HEC is unhealthy: status %d", resp.StatusCode)
 	}
-
 	return nil
 }
+
+func (c *Client) endpoint(path string) string {
+	scheme := "http"
+	if c.config.UseTLS {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s:%d%s", scheme, c.config.Host, c.config.Port, path)
+}
@@ -0,0 +1,86 @@
+package splunk
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"go.uber.org/zap"
+)
+
+// fakeHEC rejects the event whose "n" equals invalid, the way HEC does: the
+// events before it are indexed and the request stops there.
+type fakeHEC struct {
+	invalid  int
+	indexed  []int
+	requests int
+}
+
+func (h *fakeHEC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.requests++
+	body, _ := io.ReadAll(r.Body)
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for i := 0; scanner.Scan(); i++ {
+		var event Event
+		json.Unmarshal(scanner.Bytes(), &event)
+		n := int(event.Event["n"].(float64))
+		if n == h.invalid {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"text":"Invalid data format","code":6,"invalid-event-number":%d}`, i)
+			return
+		}
+		h.indexed = append(h.indexed, n)
+	}
+}
+
+func newTestClient(t *testing.T, handler http.Handler, batchMaxEvents int) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	u, _ := url.Parse(srv.URL)
+	port, _ := strconv.Atoi(u.Port())
+	return NewClient(config.SplunkConfig{Host: u.Hostname(), Port: port, Token: "test", BatchMaxEvents: batchMaxEvents}, zap.NewNop())
+}
+
+func TestSendEventsInvalidEventInBatch(t *testing.T) {
+	hec := &fakeHEC{invalid: 2}
+	c := newTestClient(t, hec, 4)
+
+	var events []Event
+	for i := 0; i < 6; i++ {
+		events = append(events, c.NewEvent("test", map[string]interface{}{"n": i}))
+	}
+
+	err := c.SendEvents(events)
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("SendEvents = %v, want a BatchError", err)
+	}
+
+	// The first request carries events 0-3 and stops at 2; the second
+	// carries 4 and 5.
+	if want := []int{0, 1, 4, 5}; !reflect.DeepEqual(hec.indexed, want) {
+		t.Errorf("HEC indexed %v, want %v", hec.indexed, want)
+	}
+	type failure struct {
+		index     int
+		retryable bool
+	}
+	var got []failure
+	for _, f := range batchErr.Failed {
+		got = append(got, failure{f.Index, f.Retryable})
+	}
+	if want := []failure{{2, false}, {3, true}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("failed events %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,573 @@
+package spool
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/fsutil"
+	"github.com/vtapaskar/brahma/internal/splunk"
+	"go.uber.org/zap"
+)
+
+const (
+	segmentExt = ".seg"
+	offsetExt  = ".off"
+
+	defaultMaxBytes          = 256 * 1024 * 1024
+	defaultSegmentBytes      = 8 * 1024 * 1024
+	defaultReplayBatchEvents = 100
+	defaultInitialBackoff    = time.Second
+	defaultMaxBackoff        = 5 * time.Minute
+)
+
+type segment struct {
+	seq  uint64
+	path string
+	size int64
+	// offset is how much of the segment Splunk has already accepted.
+	offset int64
+}
+
+// offsetPath is where the replay offset of the segment at path is kept.
+func offsetPath(path string) string {
+	return strings.TrimSuffix(path, segmentExt) + offsetExt
+}
+
+// Spool is a disk-backed queue of Splunk events that could not be delivered.
+// Events are appended to numbered segment files; once a segment is sealed the
+// replayer sends it in batches, saving its offset after each accepted batch,
+// and deletes it when it is done. When the spool exceeds its size cap the
+// oldest segments are evicted first.
+type Spool struct {
+	dir            string
+	maxBytes       int64
+	segmentBytes   int64
+	batchEvents    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	logger         *zap.Logger
+
+	mu         sync.Mutex
+	sealed     []segment
+	active     *os.File
+	activeSeg  segment
+	totalBytes int64
+	nextSeq    uint64
+	running    bool
+
+	notify   chan struct{}
+	stopChan chan struct{}
+	done     chan struct{}
+}
+
+func Open(cfg config.SpoolConfig, logger *zap.Logger) (*Spool, error) {
+	if cfg.Dir == "" {
+		return nil, fmt.Errorf("spool dir is required")
+	}
+
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	s := &Spool{
+		dir:            cfg.Dir,
+		maxBytes:       cfg.MaxBytes,
+		segmentBytes:   cfg.SegmentBytes,
+		batchEvents:    cfg.ReplayBatchEvents,
+		initialBackoff: time.Duration(cfg.InitialBackoffSeconds) * time.Second,
+		maxBackoff:     time.Duration(cfg.MaxBackoffSeconds) * time.Second,
+		logger:         logger,
+		notify:         make(chan struct{}, 1),
+		stopChan:       make(chan struct{}),
+		done:           make(chan struct{}),
+	}
+
+	if s.maxBytes <= 0 {
+		s.maxBytes = defaultMaxBytes
+	}
+	if s.segmentBytes <= 0 {
+		s.segmentBytes = defaultSegmentBytes
+	}
+	if s.segmentBytes > s.maxBytes/2 {
+		s.segmentBytes = s.maxBytes / 2
+	}
+	if s.batchEvents <= 0 {
+		s.batchEvents = defaultReplayBatchEvents
+	}
+	if s.initialBackoff <= 0 {
+		s.initialBackoff = defaultInitialBackoff
+	}
+	if s.maxBackoff < s.initialBackoff {
+		s.maxBackoff = defaultMaxBackoff
+	}
+
+	if err := s.loadSegments(); err != nil {
+		return nil, err
+	}
+
+	if len(s.sealed) > 0 {
+		s.logger.Info("Found spooled Splunk events",
+			zap.Int("segments", len(s.sealed)),
+			zap.Int64("bytes", s.totalBytes),
+		)
+		s.signal()
+	}
+
+	return s, nil
+}
+
+// loadSegments picks up segments left by a previous run, resuming each from
+// its saved replay offset. All of them are treated as sealed; new events
+// always go to a fresh segment.
+func (s *Spool) loadSegments() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read spool directory: %w", err)
+	}
+
+	var offsetFiles []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() {
+			continue
+		}
+		if !strings.HasSuffix(name, segmentExt) {
+			if strings.Contains(name, offsetExt) {
+				offsetFiles = append(offsetFiles, filepath.Join(s.dir, name))
+			}
+			continue
+		}
+
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("failed to stat spool segment: %w", err)
+		}
+
+		seg := segment{seq: seq, path: filepath.Join(s.dir, name), size: info.Size()}
+		seg.offset = readOffset(offsetPath(seg.path), seg.size)
+		if seq >= s.nextSeq {
+			s.nextSeq = seq + 1
+		}
+
+		if seg.offset >= seg.size {
+			os.Remove(seg.path)
+			continue
+		}
+
+		s.sealed = append(s.sealed, seg)
+		s.totalBytes += seg.size - seg.offset
+	}
+
+	sort.Slice(s.sealed, func(i, j int) bool { return s.sealed[i].seq < s.sealed[j].seq })
+
+	// Drop offsets whose segment is gone, and temporary files left by an
+	// interrupted writeOffset.
+	loaded := make(map[string]bool, len(s.sealed))
+	for _, seg := range s.sealed {
+		loaded[offsetPath(seg.path)] = true
+	}
+	for _, path := range offsetFiles {
+		if !loaded[path] {
+			os.Remove(path)
+		}
+	}
+
+	return nil
+}
+
+// readOffset returns the saved replay offset of a segment of the given size,
+// or 0 if there is none or it is not usable.
+func readOffset(path string, size int64) int64 {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0
+	}
+	offset, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil || offset < 0 || offset > size {
+		return 0
+	}
+	return offset
+}
+
+// writeOffset replaces the saved replay offset at path, so that a crash
+// leaves either the old or the new offset.
+func writeOffset(path string, offset int64) error {
+	tmp := path + ".tmp"
+	if err := fsutil.WriteFileSync(tmp, []byte(strconv.FormatInt(offset, 10)+"\n")); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// removeSegment deletes a segment file and its saved offset.
+func (s *Spool) removeSegment(seg segment, reason string) {
+	if err := os.Remove(seg.path); err != nil && !os.IsNotExist(err) {
+		s.logger.Warn("Failed to remove "+reason+" spool segment", zap.String("path", seg.path), zap.Error(err))
+	}
+	if err := os.Remove(offsetPath(seg.path)); err != nil && !os.IsNotExist(err) {
+		s.logger.Warn("Failed to remove spool offset", zap.String("path", seg.path), zap.Error(err))
+	}
+}
+
+// Append writes events to the active segment, rolling to a new segment when
+// it is full and evicting the oldest data once the size cap is exceeded.
+func (s *Spool) Append(events []splunk.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			s.logger.Warn("Dropping unmarshalable event from spool", zap.Error(err))
+			continue
+		}
+		line = append(line, '\n')
+
+		if s.active != nil && s.activeSeg.size+int64(len(line)) > s.segmentBytes {
+			if err := s.sealActive(); err != nil {
+				return err
+			}
+		}
+		if s.active == nil {
+			if err := s.openActive(); err != nil {
+				return err
+			}
+		}
+
+		if _, err := s.active.Write(line); err != nil {
+			return fmt.Errorf("failed to write spool segment: %w", err)
+		}
+		s.activeSeg.size += int64(len(line))
+		s.totalBytes += int64(len(line))
+	}
+
+	if s.active != nil {
+		if err := s.active.Sync(); err != nil {
+			return fmt.Errorf("failed to sync spool segment: %w", err)
+		}
+	}
+
+	s.evict()
+	s.signal()
+
+	return nil
+}
+
+func (s *Spool) openActive() error {
+	seg := segment{
+		seq:  s.nextSeq,
+		path: filepath.Join(s.dir, fmt.Sprintf("%020d%s", s.nextSeq, segmentExt)),
+	}
+
+	file, err := os.OpenFile(seg.path, os.O_CREATE|os.O_EXCL|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to create spool segment: %w", err)
+	}
+
+	s.nextSeq++
+	s.active = file
+	s.activeSeg = seg
+	return nil
+}
+
+// sealActive must be called with mu held.
+func (s *Spool) sealActive() error {
+	if s.active == nil {
+		return nil
+	}
+
+	if err := s.active.Close(); err != nil {
+		return fmt.Errorf("failed to close spool segment: %w", err)
+	}
+	s.active = nil
+
+	if s.activeSeg.size == 0 {
+		os.Remove(s.activeSeg.path)
+		return nil
+	}
+
+	s.sealed = append(s.sealed, s.activeSeg)
+	return nil
+}
+
+// evict must be called with mu held. Sealed segments go first; the active
+// segment is dropped too if it alone is over the cap, which a few very large
+// events can cause.
+func (s *Spool) evict() {
+	for s.totalBytes > s.maxBytes && len(s.sealed) > 0 {
+		oldest := s.sealed[0]
+		s.sealed = s.sealed[1:]
+		s.totalBytes -= oldest.size - oldest.offset
+		s.removeSegment(oldest, "evicted")
+
+		s.logger.Warn("Spool size cap reached, dropped oldest segment",
+			zap.String("path", oldest.path),
+			zap.Int64("bytes", oldest.size-oldest.offset),
+		)
+	}
+
+	if s.totalBytes > s.maxBytes && s.active != nil {
+		s.active.Close()
+		s.active = nil
+		s.totalBytes -= s.activeSeg.size
+		s.removeSegment(s.activeSeg, "evicted")
+
+		s.logger.Warn("Spool size cap reached, dropped active segment",
+			zap.String("path", s.activeSeg.path),
+			zap.Int64("bytes", s.activeSeg.size),
+		)
+	}
+}
+
+func (s *Spool) signal() {
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Bytes returns the amount of event data currently held in the spool.
+func (s *Spool) Bytes() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.totalBytes
+}
+
+// next returns the oldest sealed segment, sealing the active segment first if
+// it is the only data left.
+func (s *Spool) next() (segment, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.sealed) == 0 && s.active != nil && s.activeSeg.size > 0 {
+		if err := s.sealActive(); err != nil {
+			s.logger.Warn("Failed to seal spool segment", zap.Error(err))
+		}
+	}
+
+	if len(s.sealed) == 0 {
+		return segment{}, false
+	}
+	return s.sealed[0], true
+}
+
+func (s *Spool) remove(seg segment) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The segment may already have been evicted while it was being replayed.
+	if len(s.sealed) > 0 && s.sealed[0].seq == seg.seq {
+		s.totalBytes -= s.sealed[0].size - s.sealed[0].offset
+		s.sealed = s.sealed[1:]
+	}
+
+	s.removeSegment(seg, "replayed")
+}
+
+// advance records that Splunk accepted seg up to offset. It reports false if
+// the segment was evicted in the meantime.
+func (s *Spool) advance(seg segment, offset int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.sealed) == 0 || s.sealed[0].seq != seg.seq {
+		return false
+	}
+
+	// If the offset cannot be saved the segment is still replayed from
+	// memory; only a restart would resend the batch.
+	if err := writeOffset(offsetPath(seg.path), offset); err != nil {
+		s.logger.Warn("Failed to save spool replay offset", zap.String("path", seg.path), zap.Error(err))
+	}
+
+	s.totalBytes -= offset - s.sealed[0].offset
+	s.sealed[0].offset = offset
+	return true
+}
+
+// record is a spooled event and the segment offset just past it.
+type record struct {
+	event splunk.Event
+	end   int64
+}
+
+func readSegment(path string, offset int64) ([]record, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var records []record
+	reader := bufio.NewReaderSize(file, 64*1024)
+	pos := offset
+	for {
+		line, err := reader.ReadBytes('\n')
+		pos += int64(len(line))
+
+		var event splunk.Event
+		if len(line) > 0 && json.Unmarshal(line, &event) == nil {
+			records = append(records, record{event: event, end: pos})
+		}
+
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Run replays spooled segments oldest first through send until Close is
+// called. After a failed delivery it backs off exponentially, with jitter, up
+// to the configured maximum.
+func (s *Spool) Run(send func([]splunk.Event) error) {
+	s.mu.Lock()
+	s.running = true
+	s.mu.Unlock()
+	defer close(s.done)
+
+	backoff := time.Duration(0)
+	for {
+		if backoff > 0 {
+			jittered := backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+			select {
+			case <-time.After(jittered):
+			case <-s.stopChan:
+				return
+			}
+		}
+
+		seg, ok := s.next()
+		if !ok {
+			select {
+			case <-s.notify:
+				continue
+			case <-s.stopChan:
+				return
+			}
+		}
+
+		if err := s.replay(seg, send); err != nil {
+			if backoff == 0 {
+				backoff = s.initialBackoff
+			} else if backoff *= 2; backoff > s.maxBackoff {
+				backoff = s.maxBackoff
+			}
+			s.logger.Warn("Spool replay failed, backing off",
+				zap.String("segment", seg.path),
+				zap.Duration("backoff", backoff),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		backoff = 0
+	}
+}
+
+// replay sends seg from its saved offset in batches of batchEvents, saving
+// the offset after each batch so a retry or restart does not resend events
+// Splunk already accepted.
+func (s *Spool) replay(seg segment, send func([]splunk.Event) error) error {
+	records, err := readSegment(seg.path, seg.offset)
+	if os.IsNotExist(err) {
+		s.remove(seg)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read spool segment: %w", err)
+	}
+
+	for start := 0; start < len(records); start += s.batchEvents {
+		batch := records[start:min(start+s.batchEvents, len(records))]
+		events := make([]splunk.Event, len(batch))
+		for i, r := range batch {
+			events[i] = r.event
+		}
+
+		// delivered counts the events before the first one that has to be
+		// retried; everything up to there was accepted.
+		err := send(events)
+		delivered := len(batch)
+		if batchErr, ok := err.(*splunk.BatchError); ok {
+			for _, failed := range batchErr.Failed {
+				if failed.Retryable && failed.Index < delivered {
+					delivered = failed.Index
+				}
+			}
+			if delivered == len(batch) {
+				// Only events Splunk rejected outright are left; retrying
+				// them would never succeed.
+				s.logger.Warn("Dropping spooled events rejected by Splunk",
+					zap.String("segment", seg.path),
+					zap.Int("dropped", len(batchErr.Failed)),
+					zap.Error(batchErr.Failed[0].Err),
+				)
+			}
+		} else if err != nil {
+			delivered = 0
+		}
+
+		if delivered > 0 && !s.advance(seg, batch[delivered-1].end) {
+			return nil
+		}
+		if delivered < len(batch) {
+			return err
+		}
+	}
+
+	s.remove(seg)
+
+	s.logger.Info("Replayed spooled events to Splunk",
+		zap.String("segment", seg.path),
+		zap.Int("count", len(records)),
+	)
+
+	return nil
+}
+
+func (s *Spool) Close() error {
+	close(s.stopChan)
+
+	s.mu.Lock()
+	running := s.running
+	s.mu.Unlock()
+
+	if running {
+		select {
+		case <-s.done:
+		case <-time.After(30 * time.Second):
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sealActive()
+}
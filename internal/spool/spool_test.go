@@ -0,0 +1,350 @@
+package spool
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/splunk"
+	"go.uber.org/zap"
+)
+
+func openSpool(t *testing.T, cfg config.SpoolConfig) *Spool {
+	t.Helper()
+	s, err := Open(cfg, zap.NewNop())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+	return s
+}
+
+func events(from, to int) []splunk.Event {
+	var out []splunk.Event
+	for i := from; i < to; i++ {
+		out = append(out, splunk.Event{Event: map[string]interface{}{"n": i}})
+	}
+	return out
+}
+
+func numbers(events []splunk.Event) []int {
+	var out []int
+	for _, e := range events {
+		out = append(out, int(e.Event["n"].(float64)))
+	}
+	return out
+}
+
+// recorder is a send function that records what it was given and fails the
+// calls listed in fail.
+type recorder struct {
+	calls int
+	sent  []int
+	fail  map[int]error
+}
+
+func (r *recorder) send(events []splunk.Event) error {
+	r.calls++
+	if err := r.fail[r.calls]; err != nil {
+		var batchErr *splunk.BatchError
+		if !errors.As(err, &batchErr) {
+			return err
+		}
+		failed := make(map[int]bool)
+		for _, f := range batchErr.Failed {
+			failed[f.Index] = true
+		}
+		for i, n := range numbers(events) {
+			if !failed[i] {
+				r.sent = append(r.sent, n)
+			}
+		}
+		return err
+	}
+	r.sent = append(r.sent, numbers(events)...)
+	return nil
+}
+
+// drain replays everything in the spool without the Run loop's backoff.
+func drain(t *testing.T, s *Spool, r *recorder) error {
+	t.Helper()
+	for {
+		seg, ok := s.next()
+		if !ok {
+			return nil
+		}
+		if err := s.replay(seg, r.send); err != nil {
+			return err
+		}
+	}
+}
+
+func spoolFiles(t *testing.T, dir, ext string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, e := range entries {
+		if strings.HasSuffix(e.Name(), ext) {
+			names = append(names, e.Name())
+		}
+	}
+	return names
+}
+
+func seq(from, to int) []int {
+	var out []int
+	for i := from; i < to; i++ {
+		out = append(out, i)
+	}
+	return out
+}
+
+func TestSpoolAppendAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, config.SpoolConfig{Dir: dir})
+	defer s.Close()
+
+	if err := s.Append(events(0, 5)); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	if s.Bytes() == 0 {
+		t.Fatal("Bytes is 0 after Append")
+	}
+
+	r := &recorder{}
+	if err := drain(t, s, r); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if want := seq(0, 5); !reflect.DeepEqual(r.sent, want) {
+		t.Errorf("replayed %v, want %v", r.sent, want)
+	}
+	if s.Bytes() != 0 {
+		t.Errorf("Bytes = %d after replay, want 0", s.Bytes())
+	}
+	if files := spoolFiles(t, dir, segmentExt); len(files) != 0 {
+		t.Errorf("segments left after replay: %v", files)
+	}
+}
+
+func TestSpoolSealsFullSegments(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, config.SpoolConfig{Dir: dir, SegmentBytes: 100})
+	defer s.Close()
+
+	for i := 0; i < 10; i++ {
+		if err := s.Append(events(i, i+1)); err != nil {
+			t.Fatalf("Append: %v", err)
+		}
+	}
+
+	s.mu.Lock()
+	sealed := len(s.sealed)
+	s.mu.Unlock()
+	if sealed < 2 {
+		t.Fatalf("%d sealed segments, want several", sealed)
+	}
+
+	r := &recorder{}
+	if err := drain(t, s, r); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if want := seq(0, 10); !reflect.DeepEqual(r.sent, want) {
+		t.Errorf("replayed %v, want %v", r.sent, want)
+	}
+}
+
+func TestSpoolReplayResumesAfterFailure(t *testing.T) {
+	retryable := func(indexes ...int) error {
+		batchErr := &splunk.BatchError{}
+		for _, i := range indexes {
+			batchErr.Failed = append(batchErr.Failed, splunk.EventError{Index: i, Err: errors.New("503"), Retryable: true})
+		}
+		return batchErr
+	}
+
+	tests := []struct {
+		name string
+		fail error
+		// resend is the first event sent again after the failure.
+		resend int
+	}{
+		{"whole batch", errors.New("connection refused"), 2},
+		{"whole batch error", retryable(0, 1), 2},
+		{"second event", retryable(1), 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			s := openSpool(t, config.SpoolConfig{Dir: dir, ReplayBatchEvents: 2})
+			if err := s.Append(events(0, 6)); err != nil {
+				t.Fatalf("Append: %v", err)
+			}
+
+			r := &recorder{fail: map[int]error{2: tc.fail}}
+			if err := drain(t, s, r); err == nil {
+				t.Fatal("replay succeeded, want the injected failure")
+			}
+
+			// A retry in the same process resumes from the saved offset.
+			retry := &recorder{}
+			seg, _ := s.next()
+			if err := s.replay(seg, retry.send); err != nil {
+				t.Fatalf("retry: %v", err)
+			}
+			if want := seq(tc.resend, 6); !reflect.DeepEqual(retry.sent, want) {
+				t.Errorf("retry sent %v, want %v", retry.sent, want)
+			}
+			s.Close()
+		})
+
+		t.Run(tc.name+" after restart", func(t *testing.T) {
+			dir := t.TempDir()
+			s := openSpool(t, config.SpoolConfig{Dir: dir, ReplayBatchEvents: 2})
+			if err := s.Append(events(0, 6)); err != nil {
+				t.Fatalf("Append: %v", err)
+			}
+			r := &recorder{fail: map[int]error{2: tc.fail}}
+			if err := drain(t, s, r); err == nil {
+				t.Fatal("replay succeeded, want the injected failure")
+			}
+			s.Close()
+
+			reopened := openSpool(t, config.SpoolConfig{Dir: dir, ReplayBatchEvents: 2})
+			defer reopened.Close()
+			retry := &recorder{}
+			if err := drain(t, reopened, retry); err != nil {
+				t.Fatalf("replay after restart: %v", err)
+			}
+			if want := seq(tc.resend, 6); !reflect.DeepEqual(retry.sent, want) {
+				t.Errorf("replayed %v after restart, want %v", retry.sent, want)
+			}
+			if files := spoolFiles(t, dir, offsetExt); len(files) != 0 {
+				t.Errorf("offset files left after replay: %v", files)
+			}
+		})
+	}
+}
+
+func TestSpoolDropsRejectedEvents(t *testing.T) {
+	s := openSpool(t, config.SpoolConfig{Dir: t.TempDir()})
+	defer s.Close()
+	if err := s.Append(events(0, 3)); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+
+	rejected := &splunk.BatchError{Failed: []splunk.EventError{{Index: 1, Err: errors.New("invalid event"), Retryable: false}}}
+	r := &recorder{fail: map[int]error{1: rejected}}
+	if err := drain(t, s, r); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if want := []int{0, 2}; !reflect.DeepEqual(r.sent, want) {
+		t.Errorf("replayed %v, want %v", r.sent, want)
+	}
+	if s.Bytes() != 0 {
+		t.Errorf("Bytes = %d, want 0", s.Bytes())
+	}
+}
+
+func TestSpoolEviction(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, config.SpoolConfig{Dir: dir, MaxBytes: 400, SegmentBytes: 100})
+	defer s.Close()
+
+	for i := 0; i < 40; i++ {
+		if err := s.Append(events(i, i+1)); err != nil {
+			t.Fatalf("Append: %v", err)
+		}
+		if s.Bytes() > 400 {
+			t.Fatalf("Bytes = %d after event %d, over the 400 byte cap", s.Bytes(), i)
+		}
+	}
+
+	r := &recorder{}
+	if err := drain(t, s, r); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if len(r.sent) == 0 || r.sent[len(r.sent)-1] != 39 || r.sent[0] == 0 {
+		t.Errorf("replayed %v, want only the newest events", r.sent)
+	}
+}
+
+func TestSpoolEvictsOversizedActiveSegment(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, config.SpoolConfig{Dir: dir, MaxBytes: 200})
+	defer s.Close()
+
+	big := splunk.Event{Event: map[string]interface{}{"n": 0, "data": strings.Repeat("x", 300)}}
+	if err := s.Append([]splunk.Event{big}); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	if s.Bytes() > 200 {
+		t.Errorf("Bytes = %d, over the 200 byte cap", s.Bytes())
+	}
+	if files := spoolFiles(t, dir, segmentExt); len(files) != 0 {
+		t.Errorf("segments left on disk: %v", files)
+	}
+
+	// The spool keeps working after dropping its active segment.
+	if err := s.Append(events(1, 2)); err != nil {
+		t.Fatalf("Append after eviction: %v", err)
+	}
+	r := &recorder{}
+	if err := drain(t, s, r); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if want := []int{1}; !reflect.DeepEqual(r.sent, want) {
+		t.Errorf("replayed %v, want %v", r.sent, want)
+	}
+}
+
+func TestSpoolReloadAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, config.SpoolConfig{Dir: dir, SegmentBytes: 100})
+	for i := 0; i < 6; i++ {
+		if err := s.Append(events(i, i+1)); err != nil {
+			t.Fatalf("Append: %v", err)
+		}
+	}
+	before := s.Bytes()
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Leftovers of an interrupted offset write and of a finished segment.
+	for _, name := range []string{"00000000000000000099" + offsetExt, "00000000000000000001" + offsetExt + ".tmp"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("5\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reopened := openSpool(t, config.SpoolConfig{Dir: dir, SegmentBytes: 100})
+	defer reopened.Close()
+	if got := reopened.Bytes(); got != before {
+		t.Errorf("Bytes = %d after reload, want %d", got, before)
+	}
+	if files := spoolFiles(t, dir, offsetExt); len(files) != 0 {
+		t.Errorf("stale offset files kept: %v", files)
+	}
+
+	// New events go after the reloaded ones.
+	if err := reopened.Append(events(6, 7)); err != nil {
+		t.Fatalf("Append: %v", err)
+	}
+	r := &recorder{}
+	if err := drain(t, reopened, r); err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if want := seq(0, 7); !reflect.DeepEqual(r.sent, want) {
+		t.Errorf("replayed %v, want %v", r.sent, want)
+	}
+	if files := spoolFiles(t, dir, ""); len(files) != 0 {
+		t.Errorf("files left after replay: %v", files)
+	}
+}
@@ -3,11 +3,15 @@ package storage
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"path"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
@@ -64,16 +68,15 @@ func (c *S3Client) Upload(key string, data []byte) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
 
-	fullKey := key
-	if c.prefix != "" {
-		fullKey = path.Join(c.prefix, key)
-	}
+	fullKey := c.objectKey(key)
 
+	start := time.Now()
 	_, err := c.client.PutObject(ctx, &s3.PutObjectInput{
 		Bucket: aws.String(c.bucket),
 		Key:    aws.String(fullKey),
 		Body:   bytes.NewReader(data),
 	})
+	observeUpload("put_object", start, len(data), err)
 
 	if err != nil {
 		return fmt.Errorf("failed to upload to S3: %w", err)
@@ -86,10 +89,7 @@ func (c *S3Client) Download(key string) ([]byte, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
 
-	fullKey := key
-	if c.prefix != "" {
-		fullKey = path.Join(c.prefix, key)
-	}
+	fullKey := c.objectKey(key)
 
 	result, err := c.client.GetObject(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(c.bucket),
@@ -107,6 +107,71 @@ func (c *S3Client) Download(key string) ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Open streams an object from S3. The caller must close the returned body;
+// the size is -1 when S3 does not report a content length.
+func (c *S3Client) Open(ctx context.Context, key string) (io.ReadCloser, int64, error) {
+	result, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(key)),
+	})
+
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to open S3 object: %w", err)
+	}
+
+	size := int64(-1)
+	if result.ContentLength != nil {
+		size = *result.ContentLength
+	}
+
+	return result.Body, size, nil
+}
+
+// OpenRange streams length bytes of an object from S3, starting at offset.
+func (c *S3Client) OpenRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	if length == 0 {
+		return io.NopCloser(bytes.NewReader(nil)), nil
+	}
+
+	result, err := c.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(c.objectKey(key)),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to open S3 object range: %w", err)
+	}
+
+	return result.Body, nil
+}
+
+// CheckBucket verifies that the bucket is reachable with the configured
+// credentials.
+func (c *S3Client) CheckBucket(ctx context.Context) error {
+	_, err := c.client.HeadBucket(ctx, &s3.HeadBucketInput{
+		Bucket: aws.String(c.bucket),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reach S3 bucket: %w", err)
+	}
+	return nil
+}
+
+// IsNotFound reports whether err is S3 answering that an object does not
+// exist.
+func IsNotFound(err error) bool {
+	var respErr *awshttp.ResponseError
+	return errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound
+}
+
+func (c *S3Client) objectKey(key string) string {
+	if c.prefix == "" {
+		return key
+	}
+	return path.Join(c.prefix, key)
+}
+
 func (c *S3Client) GenerateKey(deviceID, reportType, reportID, filename string) string {
 	timestamp := time.Now().Format("2006/01/02")
 	return path.Join(timestamp, deviceID, reportType, fmt.Sprintf("%s_%s", reportID, filename))
@@ -114,8 +179,11 @@ func (c *S3Client) GenerateKey(deviceID, reportType, reportID, filename string)
 
 func (c *S3Client) GenerateLogKey(deviceUID, logID, logType string) string {
 	suffix := ".crash"
-	if logType == "backtrace" {
+	switch logType {
+	case "backtrace":
 		suffix = ".backtrace"
+	case "techsupport":
+		suffix = ".tar.gz"
 	}
 	return path.Join(deviceUID, fmt.Sprintf("%s%s", logID, suffix))
 }
@@ -124,10 +192,7 @@ func (c *S3Client) Delete(key string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	fullKey := key
-	if c.prefix != "" {
-		fullKey = path.Join(c.prefix, key)
-	}
+	fullKey := c.objectKey(key)
 
 	_, err := c.client.DeleteObject(ctx, &s3.DeleteObjectInput{
 		Bucket: aws.String(c.bucket),
@@ -0,0 +1,245 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/vtapaskar/brahma/internal/telemetry"
+)
+
+// PartSize is the amount of data buffered per upload before it is sent to
+// S3 as one multipart part. S3 requires every part except the last to be at
+// least 5 MiB, so this is also the memory bound of a single upload stream.
+const PartSize = 8 * 1024 * 1024
+
+// MaxParts is the most parts S3 accepts in one multipart upload, which caps
+// an object at MaxObjectSize bytes.
+const (
+	MaxParts      = 10000
+	MaxObjectSize = MaxParts * PartSize
+)
+
+// ErrObjectTooLarge is returned by Write and Complete for objects larger than
+// MaxObjectSize, before anything past the limit is sent to S3.
+var ErrObjectTooLarge = fmt.Errorf("object exceeds the S3 limit of %d parts of %d bytes", MaxParts, PartSize)
+
+// Upload streams an object into S3. Data written to it is sent as multipart
+// parts once a full part has been buffered; objects that never fill a part
+// are stored with a single PutObject when the upload is completed. Every
+// request carries the SHA-256 of its body, which S3 verifies and keeps as the
+// object's checksum.
+type Upload struct {
+	client   *S3Client
+	ctx      context.Context
+	key      string
+	buf      []byte
+	uploadID *string
+	parts    []types.CompletedPart
+	metadata map[string]string
+	size     int64
+	closed   bool
+}
+
+func (c *S3Client) NewUpload(ctx context.Context, key string) *Upload {
+	return &Upload{
+		client: c,
+		ctx:    ctx,
+		key:    c.objectKey(key),
+		buf:    make([]byte, 0, PartSize),
+	}
+}
+
+func (u *Upload) Write(p []byte) (int, error) {
+	if u.closed {
+		return 0, fmt.Errorf("upload already closed")
+	}
+
+	written := 0
+	for len(p) > 0 {
+		n := copy(u.buf[len(u.buf):cap(u.buf)], p)
+		u.buf = u.buf[:len(u.buf)+n]
+		p = p[n:]
+		written += n
+
+		if len(u.buf) == cap(u.buf) {
+			if err := u.flushPart(); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	u.size += int64(written)
+	return written, nil
+}
+
+// SetMetadata sets the user metadata stored with the object. S3 only accepts
+// metadata when an object is created, so it reports false, and changes
+// nothing, once the first multipart part has been sent.
+func (u *Upload) SetMetadata(metadata map[string]string) bool {
+	if u.uploadID != nil {
+		return false
+	}
+	u.metadata = metadata
+	return true
+}
+
+// Size returns the number of bytes written so far.
+func (u *Upload) Size() int64 {
+	return u.size
+}
+
+func (u *Upload) flushPart() error {
+	if len(u.parts) >= MaxParts {
+		return ErrObjectTooLarge
+	}
+
+	if u.uploadID == nil {
+		ctx, cancel := context.WithTimeout(u.ctx, 30*time.Second)
+		defer cancel()
+
+		out, err := u.client.client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+			Bucket:            aws.String(u.client.bucket),
+			Key:               aws.String(u.key),
+			ChecksumAlgorithm: types.ChecksumAlgorithmSha256,
+			Metadata:          u.metadata,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create multipart upload: %w", err)
+		}
+		u.uploadID = out.UploadId
+	}
+
+	ctx, cancel := context.WithTimeout(u.ctx, 60*time.Second)
+	defer cancel()
+
+	partNumber := int32(len(u.parts) + 1)
+	checksum := checksumSHA256(u.buf)
+	start := time.Now()
+	out, err := u.client.client.UploadPart(ctx, &s3.UploadPartInput{
+		Bucket:         aws.String(u.client.bucket),
+		Key:            aws.String(u.key),
+		UploadId:       u.uploadID,
+		PartNumber:     aws.Int32(partNumber),
+		Body:           bytes.NewReader(u.buf),
+		ChecksumSHA256: checksum,
+	})
+	observeUpload("upload_part", start, len(u.buf), err)
+	if err != nil {
+		return fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+	}
+
+	u.parts = append(u.parts, types.CompletedPart{
+		ETag:           out.ETag,
+		PartNumber:     aws.Int32(partNumber),
+		ChecksumSHA256: checksum,
+	})
+	u.buf = u.buf[:0]
+
+	return nil
+}
+
+// Complete uploads whatever is still buffered and makes the object visible.
+func (u *Upload) Complete() error {
+	if u.closed {
+		return fmt.Errorf("upload already closed")
+	}
+	u.closed = true
+
+	if u.uploadID == nil {
+		ctx, cancel := context.WithTimeout(u.ctx, 60*time.Second)
+		defer cancel()
+
+		start := time.Now()
+		_, err := u.client.client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:         aws.String(u.client.bucket),
+			Key:            aws.String(u.key),
+			Body:           bytes.NewReader(u.buf),
+			ChecksumSHA256: checksumSHA256(u.buf),
+			Metadata:       u.metadata,
+		})
+		observeUpload("put_object", start, len(u.buf), err)
+		if err != nil {
+			return fmt.Errorf("failed to upload to S3: %w", err)
+		}
+		return nil
+	}
+
+	if len(u.buf) > 0 {
+		if err := u.flushPart(); err != nil {
+			u.abort()
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(u.ctx, 60*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := u.client.client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(u.client.bucket),
+		Key:             aws.String(u.key),
+		UploadId:        u.uploadID,
+		MultipartUpload: &types.CompletedMultipartUpload{Parts: u.parts},
+	})
+	observeUpload("complete_multipart_upload", start, 0, err)
+	if err != nil {
+		u.abort()
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+
+	return nil
+}
+
+// checksumSHA256 returns the base64 SHA-256 of data, as S3 expects it.
+func checksumSHA256(data []byte) *string {
+	sum := sha256.Sum256(data)
+	return aws.String(base64.StdEncoding.EncodeToString(sum[:]))
+}
+
+// Abort discards the upload and any parts already sent to S3. It is safe to
+// call after Complete, in which case it does nothing.
+func (u *Upload) Abort() error {
+	if u.closed {
+		return nil
+	}
+	u.closed = true
+	return u.abort()
+}
+
+// observeUpload records an S3 upload request; bytes only count once S3 has
+// accepted them.
+func observeUpload(operation string, start time.Time, size int, err error) {
+	telemetry.S3RequestDuration.WithLabelValues(operation, telemetry.Result(err)).Observe(telemetry.Since(start))
+	if err == nil {
+		telemetry.S3UploadBytes.Add(float64(size))
+	}
+}
+
+func (u *Upload) abort() error {
+	u.buf = nil
+	if u.uploadID == nil {
+		return nil
+	}
+
+	// The stream context is usually what failed, so don't reuse it here.
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err := u.client.client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(u.client.bucket),
+		Key:      aws.String(u.key),
+		UploadId: u.uploadID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+
+	return nil
+}
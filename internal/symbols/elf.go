@@ -0,0 +1,132 @@
+package symbols
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"encoding/hex"
+	"fmt"
+	"sort"
+)
+
+// symbolFile is a parsed ELF file: its function symbols and, when it carries
+// DWARF, its line tables.
+type symbolFile struct {
+	file     *elf.File
+	dwarf    *dwarf.Data
+	funcs    []elf.Symbol
+	byName   map[string]uint64
+	loadBase uint64
+	dynamic  bool
+
+	// refs and dropped are guarded by Store.mu: a file dropped from the
+	// cache is closed when its last user releases it.
+	refs    int
+	dropped bool
+}
+
+// location is where an address resolved to. File and Line are empty without
+// DWARF line information.
+type location struct {
+	Function string
+	File     string
+	Line     int
+}
+
+func openSymbolFile(path string) (*symbolFile, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open symbol file: %w", err)
+	}
+
+	sf := &symbolFile{
+		file:    f,
+		byName:  make(map[string]uint64),
+		dynamic: f.Type == elf.ET_DYN,
+	}
+
+	if d, err := f.DWARF(); err == nil {
+		sf.dwarf = d
+	}
+
+	syms, _ := f.Symbols()
+	for _, sym := range syms {
+		if elf.ST_TYPE(sym.Info) != elf.STT_FUNC || sym.Value == 0 {
+			continue
+		}
+		sf.funcs = append(sf.funcs, sym)
+		sf.byName[sym.Name] = sym.Value
+	}
+	sort.Slice(sf.funcs, func(i, j int) bool { return sf.funcs[i].Value < sf.funcs[j].Value })
+
+	first := true
+	for _, prog := range f.Progs {
+		if prog.Type == elf.PT_LOAD && (first || prog.Vaddr < sf.loadBase) {
+			sf.loadBase = prog.Vaddr &^ (prog.Align - 1)
+			first = false
+		}
+	}
+
+	return sf, nil
+}
+
+func (f *symbolFile) close() {
+	f.file.Close()
+}
+
+// resolve looks up a link-time address.
+func (f *symbolFile) resolve(addr uint64) (location, bool) {
+	var loc location
+
+	i := sort.Search(len(f.funcs), func(i int) bool { return f.funcs[i].Value > addr }) - 1
+	if i >= 0 {
+		sym := f.funcs[i]
+		if sym.Size == 0 || addr < sym.Value+sym.Size {
+			loc.Function = sym.Name
+		}
+	}
+
+	if f.dwarf != nil {
+		if cu, err := f.dwarf.Reader().SeekPC(addr); err == nil {
+			if lr, err := f.dwarf.LineReader(cu); err == nil && lr != nil {
+				var entry dwarf.LineEntry
+				if lr.SeekPC(addr, &entry) == nil && entry.File != nil {
+					loc.File = entry.File.Name
+					loc.Line = entry.Line
+				}
+			}
+		}
+	}
+
+	return loc, loc.Function != "" || loc.File != ""
+}
+
+const ntGNUBuildID = 3
+
+func readBuildID(path string) (string, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	section := f.Section(".note.gnu.build-id")
+	if section == nil {
+		return "", nil
+	}
+	data, err := section.Data()
+	if err != nil || len(data) < 12 {
+		return "", nil
+	}
+
+	nameSize := f.ByteOrder.Uint32(data[0:4])
+	descSize := f.ByteOrder.Uint32(data[4:8])
+	if f.ByteOrder.Uint32(data[8:12]) != ntGNUBuildID {
+		return "", nil
+	}
+	start := 12 + uint64(nameSize+3)&^3
+	end := start + uint64(descSize)
+	if end > uint64(len(data)) {
+		return "", nil
+	}
+	return hex.EncodeToString(data[start:end]), nil
+}
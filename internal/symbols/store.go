@@ -0,0 +1,300 @@
+// Package symbols keeps the debug symbols release engineering uploads for
+// each SONiC version and daemon, and uses them to turn the raw addresses in
+// device backtraces into function, file and line.
+package symbols
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/vtapaskar/brahma/internal/config"
+	"github.com/vtapaskar/brahma/internal/crash"
+)
+
+// ErrInvalidBundle is returned by Put for uploads that are neither an ELF
+// file nor a tar.gz of them.
+var ErrInvalidBundle = errors.New("invalid symbol bundle")
+
+var (
+	elfMagic  = []byte("\x7fELF")
+	gzipMagic = []byte{0x1f, 0x8b}
+)
+
+// Store keeps symbol files under dir/<version>/<process tag>/. A bundle is a
+// single ELF file (usually a .debug file) or a tar.gz of them, for example a
+// build-id indexed .build-id/ab/cdef....debug tree.
+type Store struct {
+	dir string
+
+	// mu guards the caches only; indexing a bundle and parsing symbol files
+	// happen outside it. generation counts invalidations, so work that
+	// raced with an upload is not cached.
+	mu         sync.Mutex
+	modules    map[string]*moduleIndex
+	files      map[string]*symbolFile
+	generation uint64
+}
+
+func NewStore(cfg config.SymbolsConfig) (*Store, error) {
+	if err := os.MkdirAll(cfg.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create symbol store directory: %w", err)
+	}
+
+	return &Store{
+		dir:     cfg.Dir,
+		modules: make(map[string]*moduleIndex),
+		files:   make(map[string]*symbolFile),
+	}, nil
+}
+
+// bundleDir is where the symbols for version and processTag live.
+func (s *Store) bundleDir(version, processTag string) (string, error) {
+	processTag = crash.NormalizeProcessTag(processTag)
+	for _, part := range []string{version, processTag} {
+		if part == "" || part == "." || part == ".." || strings.ContainsAny(part, `/\`) {
+			return "", fmt.Errorf("%w: invalid version or process tag %q", ErrInvalidBundle, part)
+		}
+	}
+	return filepath.Join(s.dir, version, processTag), nil
+}
+
+// Put stores a symbol bundle for version and processTag and returns the paths
+// of the files it added, relative to the bundle directory.
+func (s *Store) Put(version, processTag, filename string, r io.Reader) ([]string, error) {
+	dir, err := s.bundleDir(version, processTag)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create symbol bundle directory: %w", err)
+	}
+
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(4)
+
+	var stored []string
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		stored, err = s.extract(dir, br)
+	case bytes.HasPrefix(magic, elfMagic):
+		name := filepath.Base(filename)
+		if name == "." || name == "/" || name == ".." {
+			return nil, fmt.Errorf("%w: filename is required for an ELF file", ErrInvalidBundle)
+		}
+		if err = writeFile(filepath.Join(dir, name), br); err == nil {
+			stored = []string{name}
+		}
+	default:
+		return nil, fmt.Errorf("%w: not an ELF file or tar.gz archive", ErrInvalidBundle)
+	}
+
+	s.invalidate(dir)
+	return stored, err
+}
+
+// extract unpacks the ELF files of a tar.gz bundle into dir.
+func (s *Store) extract(dir string, r io.Reader) ([]string, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+	}
+	defer gz.Close()
+
+	var stored []string
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return stored, nil
+		}
+		if err != nil {
+			return stored, fmt.Errorf("%w: %v", ErrInvalidBundle, err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := filepath.Clean(strings.TrimPrefix(header.Name, "/"))
+		if name == "." || name == ".." || strings.HasPrefix(name, "../") {
+			continue
+		}
+
+		br := bufio.NewReader(tr)
+		if magic, _ := br.Peek(4); !bytes.Equal(magic, elfMagic) {
+			continue
+		}
+
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return stored, fmt.Errorf("failed to create symbol directory: %w", err)
+		}
+		if err := writeFile(path, br); err != nil {
+			return stored, err
+		}
+		stored = append(stored, name)
+	}
+}
+
+// writeFile replaces path atomically, so a file being read by the symbolizer
+// is never seen half written.
+func writeFile(path string, r io.Reader) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create symbol file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write symbol file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write symbol file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store symbol file: %w", err)
+	}
+	return nil
+}
+
+// invalidate forgets the index and open files of a bundle directory after an
+// upload changed it.
+func (s *Store) invalidate(dir string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.generation++
+	delete(s.modules, dir)
+	for path, file := range s.files {
+		if strings.HasPrefix(path, dir+string(filepath.Separator)) {
+			s.drop(path, file)
+		}
+	}
+}
+
+// moduleIndex maps the build IDs and module names of a bundle's ELF files to
+// their paths.
+type moduleIndex struct {
+	byBuildID map[string]string
+	byName    map[string]string
+}
+
+// index returns the module index of a bundle directory, building it on first
+// use.
+func (s *Store) index(dir string) *moduleIndex {
+	s.mu.Lock()
+	idx, ok := s.modules[dir]
+	generation := s.generation
+	s.mu.Unlock()
+	if ok {
+		return idx
+	}
+
+	idx = buildIndex(dir)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if generation == s.generation {
+		s.modules[dir] = idx
+	}
+	return idx
+}
+
+func buildIndex(dir string) *moduleIndex {
+	idx := &moduleIndex{
+		byBuildID: make(map[string]string),
+		byName:    make(map[string]string),
+	}
+	filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || !d.Type().IsRegular() || strings.HasPrefix(d.Name(), ".upload-") {
+			return nil
+		}
+		id, err := readBuildID(path)
+		if err != nil {
+			return nil
+		}
+		if id != "" {
+			idx.byBuildID[id] = path
+		}
+		idx.byName[strings.TrimSuffix(d.Name(), ".debug")] = path
+		return nil
+	})
+
+	return idx
+}
+
+// maxOpenFiles bounds how many symbol files are kept parsed in memory.
+const maxOpenFiles = 16
+
+// acquire returns the parsed symbol file at path, from the cache or freshly
+// opened, and holds it open until the matching release.
+func (s *Store) acquire(path string) (*symbolFile, error) {
+	s.mu.Lock()
+	if file, ok := s.files[path]; ok {
+		file.refs++
+		s.mu.Unlock()
+		return file, nil
+	}
+	generation := s.generation
+	s.mu.Unlock()
+
+	file, err := openSymbolFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cached, ok := s.files[path]; ok {
+		file.close()
+		cached.refs++
+		return cached, nil
+	}
+
+	file.refs++
+	if generation != s.generation {
+		// The bundle changed while the file was parsed: use it for this
+		// call only.
+		file.dropped = true
+		return file, nil
+	}
+
+	if len(s.files) >= maxOpenFiles {
+		for p, f := range s.files {
+			s.drop(p, f)
+			break
+		}
+	}
+	s.files[path] = file
+	return file, nil
+}
+
+func (s *Store) release(file *symbolFile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file.refs--
+	if file.refs == 0 && file.dropped {
+		file.close()
+	}
+}
+
+// drop must be called with mu held. It removes a file from the cache and
+// closes it once no Symbolize call is using it.
+func (s *Store) drop(path string, file *symbolFile) {
+	delete(s.files, path)
+	file.dropped = true
+	if file.refs == 0 {
+		file.close()
+	}
+}
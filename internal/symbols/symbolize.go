@@ -0,0 +1,154 @@
+package symbols
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/vtapaskar/brahma/internal/crash"
+)
+
+var (
+	// glibc backtrace_symbols: "/usr/bin/orchagent(+0x1c2d3) [0x55d5c8a1b2c3]"
+	// or "/usr/lib/libswsscommon.so.0(_ZN4swss5Table3getEv+0x2c) [0x7f3a2b1c4e97]".
+	glibcAddress = regexp.MustCompile(`(\S+?)\(([^()\s+]*)\+0x([0-9a-fA-F]+)\)\s*\[0x([0-9a-fA-F]+)\]`)
+
+	// gdb frames it could not symbolize itself: "#3  0x000055d5c8a1b2c3 in ?? ()",
+	// optionally followed by "from /usr/lib/libfoo.so".
+	gdbAddress = regexp.MustCompile(`#(\d+)\s+0x([0-9a-fA-F]+)\s+in\s+\?\?\s+\(\)(?:\s+from\s+(\S+))?`)
+
+	buildIDTag = regexp.MustCompile(`(?i)build-?id[:=\s]+([0-9a-f]{8,})`)
+)
+
+// Symbolize resolves the unsymbolized frames of a backtrace from a device
+// running version of processTag. Resolved frames are rewritten in gdb style,
+// "#N  0xADDR in function () at file:line"; every other line is kept as is.
+// It returns the text and how many frames were resolved.
+//
+// Frames are matched to symbol files by build ID when the line carries one,
+// then by module name, and frames without a module belong to the process
+// binary. Offsets into a module (glibc "(+0x...)" or "(symbol+0x...)") resolve
+// in any ELF file; absolute gdb addresses only in non-PIE executables, since
+// the load address of anything else is unknown.
+func (s *Store) Symbolize(version, processTag string, text []byte) ([]byte, int) {
+	dir, err := s.bundleDir(version, processTag)
+	if err != nil {
+		return text, 0
+	}
+
+	idx := s.index(dir)
+	if len(idx.byName) == 0 {
+		return text, 0
+	}
+
+	held := make(map[string]*symbolFile)
+	defer func() {
+		for _, file := range held {
+			s.release(file)
+		}
+	}()
+
+	lines := strings.Split(string(text), "\n")
+	resolved := 0
+	frame := 0
+	for i, line := range lines {
+		var (
+			module  string
+			depth   int
+			address uint64
+			lookup  func(f *symbolFile) (uint64, bool)
+		)
+
+		if m := glibcAddress.FindStringSubmatch(line); m != nil {
+			module, depth = m[1], frame
+			symbol := m[2]
+			offset, _ := strconv.ParseUint(m[3], 16, 64)
+			address, _ = strconv.ParseUint(m[4], 16, 64)
+			lookup = func(f *symbolFile) (uint64, bool) {
+				if symbol == "" {
+					return f.loadBase + offset, true
+				}
+				base, ok := f.byName[symbol]
+				return base + offset, ok
+			}
+			frame++
+		} else if m := gdbAddress.FindStringSubmatch(line); m != nil {
+			module = m[3]
+			depth, _ = strconv.Atoi(m[1])
+			address, _ = strconv.ParseUint(m[2], 16, 64)
+			lookup = func(f *symbolFile) (uint64, bool) {
+				return address, !f.dynamic
+			}
+		} else {
+			continue
+		}
+
+		file := s.fileFor(idx, held, line, module, processTag)
+		if file == nil {
+			continue
+		}
+		pc, ok := lookup(file)
+		if !ok {
+			continue
+		}
+		// Outer frames hold return addresses, which point just past the call.
+		if depth > 0 && pc > 0 {
+			pc--
+		}
+
+		loc, ok := file.resolve(pc)
+		if !ok {
+			continue
+		}
+		lines[i] = formatFrame(depth, address, loc, module)
+		resolved++
+	}
+
+	return []byte(strings.Join(lines, "\n")), resolved
+}
+
+// fileFor returns the symbol file for a frame, acquiring it into held the
+// first time it is needed.
+func (s *Store) fileFor(idx *moduleIndex, held map[string]*symbolFile, line, module, processTag string) *symbolFile {
+	path := ""
+	if m := buildIDTag.FindStringSubmatch(line); m != nil {
+		path = idx.byBuildID[strings.ToLower(m[1])]
+	}
+	if path == "" && module != "" {
+		path = idx.byName[strings.TrimSuffix(filepath.Base(module), ".debug")]
+	}
+	if path == "" && module == "" {
+		path = idx.byName[crash.NormalizeProcessTag(processTag)]
+	}
+	if path == "" {
+		return nil
+	}
+
+	if file, ok := held[path]; ok {
+		return file
+	}
+	file, err := s.acquire(path)
+	if err != nil {
+		return nil
+	}
+	held[path] = file
+	return file
+}
+
+func formatFrame(depth int, address uint64, loc location, module string) string {
+	function := loc.Function
+	if function == "" {
+		function = "??"
+	}
+
+	frame := fmt.Sprintf("#%d  0x%016x in %s ()", depth, address, function)
+	switch {
+	case loc.File != "":
+		frame += fmt.Sprintf(" at %s:%d", loc.File, loc.Line)
+	case module != "":
+		frame += " from " + module
+	}
+	return frame
+}
@@ -0,0 +1,97 @@
+package symbols
+
+import (
+	"debug/elf"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/vtapaskar/brahma/internal/config"
+)
+
+// TestSymbolizeConcurrent is meant for -race: symbolizing runs outside the
+// store lock, so uploads that invalidate the cache must not close a symbol
+// file another call is still reading.
+func TestSymbolizeConcurrent(t *testing.T) {
+	gcc, err := exec.LookPath("gcc")
+	if err != nil {
+		t.Skip("gcc not found")
+	}
+	dir := t.TempDir()
+	source := filepath.Join(dir, "symtest.c")
+	exe := filepath.Join(dir, "symtest")
+	if err := os.WriteFile(source, []byte("int do_task(void) { return 1; }\nint main(void) { return do_task(); }\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if output, err := exec.Command(gcc, "-g", "-O0", "-no-pie", "-o", exe, source).CombinedOutput(); err != nil {
+		t.Skipf("gcc cannot build a test binary: %v\n%s", err, output)
+	}
+
+	file, err := elf.Open(exe)
+	if err != nil {
+		t.Fatalf("elf.Open: %v", err)
+	}
+	defer file.Close()
+
+	var target elf.Symbol
+	syms, _ := file.Symbols()
+	for _, sym := range syms {
+		if sym.Name == "do_task" {
+			target = sym
+		}
+	}
+
+	store, err := NewStore(config.SymbolsConfig{Dir: filepath.Join(dir, "store")})
+	if err != nil {
+		t.Fatalf("NewStore: %v", err)
+	}
+	put := func() {
+		f, err := os.Open(exe)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer f.Close()
+		if _, err := store.Put("1.0", "symtest", "symtest", f); err != nil {
+			t.Errorf("Put: %v", err)
+		}
+	}
+	put()
+
+	var base uint64
+	for _, prog := range file.Progs {
+		if prog.Type == elf.PT_LOAD {
+			base = prog.Vaddr &^ (prog.Align - 1)
+			break
+		}
+	}
+	pc := target.Value + 4
+	backtrace := fmt.Sprintf("/usr/bin/symtest(+0x%x) [0x%x]\n", pc-base, pc)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				out, n := store.Symbolize("1.0", "symtest", []byte(backtrace))
+				if n != 1 || !strings.Contains(string(out), "in do_task () at ") {
+					t.Errorf("Symbolize = %d frames: %s", n, out)
+					return
+				}
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for j := 0; j < 3; j++ {
+			put()
+		}
+	}()
+	wg.Wait()
+}
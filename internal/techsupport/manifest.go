@@ -0,0 +1,235 @@
+// Package techsupport indexes the sonic_dump_*.tar.gz archives produced by
+// "show techsupport". Indexing reads the stored archive once and records, for
+// each member file, where its content starts in the uncompressed tar stream
+// and how much of the compressed archive has to be read to get to its end. A
+// single member can then be fetched with a ranged read of the archive's
+// prefix. The members an escalation usually starts with are also handed to an
+// Extractor while indexing, so they can be stored on their own and fetched
+// without reading the archive at all.
+package techsupport
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Member kinds, for the files an escalation usually starts with.
+const (
+	KindSyslog    = "syslog"
+	KindCore      = "core"
+	KindConfigDB  = "config_db"
+	KindRedisDump = "redis_dump"
+	KindOther     = "other"
+)
+
+// Member is one regular file in an archive. Offset is where its content
+// starts in the uncompressed tar stream; the first ArchiveEnd bytes of the
+// compressed archive are enough to decompress it. Key is where an Extractor
+// stored a copy of it, if it did.
+type Member struct {
+	Path       string    `json:"path"`
+	Kind       string    `json:"kind"`
+	Size       int64     `json:"size"`
+	Offset     int64     `json:"offset"`
+	ArchiveEnd int64     `json:"archive_end"`
+	Key        string    `json:"key,omitempty"`
+	ModTime    time.Time `json:"mod_time"`
+}
+
+// Extractor stores the content of the member at path on its own and returns
+// where it was stored. Index calls it for every member whose kind is not
+// KindOther. A member whose extraction fails is still listed, without a key.
+type Extractor func(path string, content io.Reader) (string, error)
+
+// Limits bound how much Index decompresses, so that a small archive cannot
+// keep it busy for hours. Zero means the default.
+type Limits struct {
+	MaxBytes       int64
+	MaxMemberBytes int64
+}
+
+const (
+	DefaultMaxBytes       = 64 << 30
+	DefaultMaxMemberBytes = 16 << 30
+)
+
+// ErrTooLarge is returned by Index when an archive or one of its members is
+// over its Limits.
+var ErrTooLarge = errors.New("techsupport archive exceeds the indexing limits")
+
+// Manifest lists the members of an archive in archive order. Error is set
+// when the archive could not be read to the end; the members before the
+// failure are still listed.
+type Manifest struct {
+	Members []Member `json:"members"`
+	Error   string   `json:"error,omitempty"`
+}
+
+// Find looks a member up by its path in the archive.
+func (m *Manifest) Find(name string) (*Member, bool) {
+	name = cleanPath(name)
+	for i := range m.Members {
+		if m.Members[i].Path == name {
+			return &m.Members[i], true
+		}
+	}
+	return nil, false
+}
+
+var (
+	syslogFile   = regexp.MustCompile(`(^|/)log/syslog(\.\d+)?(\.gz)?$`)
+	coreFile     = regexp.MustCompile(`(^|/)core/[^/]+\.core(\.gz)?$`)
+	configDBFile = regexp.MustCompile(`(^|/)config_db\d*\.json$`)
+	redisDBFile  = regexp.MustCompile(`(^|/)dump/[A-Z_]+_DB(\.json)?$|\.rdb$`)
+)
+
+// Classify returns the kind of the member at path.
+func Classify(name string) string {
+	switch {
+	case syslogFile.MatchString(name):
+		return KindSyslog
+	case coreFile.MatchString(name):
+		return KindCore
+	case configDBFile.MatchString(name):
+		return KindConfigDB
+	case redisDBFile.MatchString(name):
+		return KindRedisDump
+	default:
+		return KindOther
+	}
+}
+
+// Index reads a gzipped tar archive from r and lists its regular files,
+// passing the escalation members to extract if it is not nil. It returns the
+// manifest of what it read, with an error if the archive is malformed,
+// truncated or over limits.
+func Index(r io.Reader, limits Limits, extract Extractor) (*Manifest, error) {
+	manifest := &Manifest{}
+	if limits.MaxBytes <= 0 {
+		limits.MaxBytes = DefaultMaxBytes
+	}
+	if limits.MaxMemberBytes <= 0 {
+		limits.MaxMemberBytes = DefaultMaxMemberBytes
+	}
+
+	compressed := &countingReader{r: r}
+	gz, err := gzip.NewReader(compressed)
+	if err != nil {
+		return manifest, manifest.fail(fmt.Errorf("failed to read techsupport archive: %w", err))
+	}
+	defer gz.Close()
+
+	// Everything tar reads goes through tarStream, so its count is the
+	// uncompressed offset; tar.Reader reads no further than it has to.
+	tarStream := &countingReader{r: io.LimitReader(gz, limits.MaxBytes+1)}
+	tr := tar.NewReader(tarStream)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF && tarStream.n <= limits.MaxBytes {
+			return manifest, nil
+		}
+		if err != nil {
+			if tarStream.n > limits.MaxBytes {
+				err = fmt.Errorf("%w: more than %d bytes uncompressed", ErrTooLarge, limits.MaxBytes)
+			}
+			return manifest, manifest.fail(fmt.Errorf("failed to read techsupport archive: %w", err))
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := cleanPath(header.Name)
+		if name == "" {
+			continue
+		}
+
+		if header.Size > limits.MaxMemberBytes {
+			return manifest, manifest.fail(fmt.Errorf("%w: member %s has %d bytes, the limit is %d", ErrTooLarge, name, header.Size, limits.MaxMemberBytes))
+		}
+		if header.Size > limits.MaxBytes-tarStream.n {
+			return manifest, manifest.fail(fmt.Errorf("%w: more than %d bytes uncompressed", ErrTooLarge, limits.MaxBytes))
+		}
+
+		member := Member{
+			Path:    name,
+			Kind:    Classify(name),
+			Offset:  tarStream.n,
+			ModTime: header.ModTime.UTC(),
+		}
+		content := &countingReader{r: tr}
+		if extract != nil && member.Kind != KindOther {
+			if key, err := extract(name, content); err == nil {
+				member.Key = key
+			}
+		}
+		// Whatever the extractor left unread still has to be skipped.
+		if _, err := io.Copy(io.Discard, content); err != nil {
+			return manifest, manifest.fail(fmt.Errorf("failed to read techsupport member %s: %w", name, err))
+		}
+		member.Size = content.n
+		member.ArchiveEnd = compressed.n
+		manifest.Members = append(manifest.Members, member)
+	}
+}
+
+// OpenMember returns the content of m, given a reader over at least the first
+// m.ArchiveEnd bytes of the archive it was indexed from.
+func OpenMember(archive io.Reader, m *Member) (io.Reader, error) {
+	gz, err := gzip.NewReader(archive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read techsupport archive: %w", err)
+	}
+	if _, err := io.CopyN(io.Discard, gz, m.Offset); err != nil {
+		return nil, fmt.Errorf("failed to seek to techsupport member %s: %w", m.Path, err)
+	}
+	return &memberReader{r: io.LimitReader(gz, m.Size), left: m.Size, path: m.Path}, nil
+}
+
+// memberReader turns an archive that ends before the member does into an
+// error rather than a short read.
+type memberReader struct {
+	r    io.Reader
+	left int64
+	path string
+}
+
+func (r *memberReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.left -= int64(n)
+	if err == io.EOF && r.left > 0 {
+		return n, fmt.Errorf("techsupport member %s is missing %d bytes", r.path, r.left)
+	}
+	if err != nil && err != io.EOF {
+		return n, fmt.Errorf("failed to read techsupport member %s: %w", r.path, err)
+	}
+	return n, err
+}
+
+// countingReader counts the bytes read through it.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func (m *Manifest) fail(err error) error {
+	m.Error = err.Error()
+	return err
+}
+
+func cleanPath(name string) string {
+	name = path.Clean("/" + strings.TrimPrefix(name, "./"))
+	return strings.TrimPrefix(name, "/")
+}
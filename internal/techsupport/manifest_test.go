@@ -0,0 +1,139 @@
+package techsupport
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+type file struct {
+	name    string
+	content []byte
+}
+
+func archive(t *testing.T, files []file) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	tw.WriteHeader(&tar.Header{Name: "dump/", Typeflag: tar.TypeDir, Mode: 0o755})
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Typeflag: tar.TypeReg, Mode: 0o644, Size: int64(len(f.content))}); err != nil {
+			t.Fatal(err)
+		}
+		tw.Write(f.content)
+	}
+	tw.Close()
+	gz.Close()
+	return buf.Bytes()
+}
+
+func TestIndexAndOpenMember(t *testing.T) {
+	// Incompressible content, so members end well apart in the archive.
+	random := make([]byte, 200000)
+	rand.New(rand.NewSource(1)).Read(random)
+	files := []file{
+		{"dump/log/syslog", []byte("Oct 17 10:10:10 sonic swss#orchagent: :- main: Orchagent started\n")},
+		{"dump/core/orchagent.1697537410.1234.core.gz", random},
+		{"./dump/etc/sonic/config_db.json", []byte(`{"DEVICE_METADATA": {}}`)},
+		{"dump/proc/empty", nil},
+	}
+	data := archive(t, files)
+
+	extracted := make(map[string][]byte)
+	extract := func(path string, content io.Reader) (string, error) {
+		// Reading only part of a member must not throw the index off.
+		b, err := io.ReadAll(io.LimitReader(content, 1000))
+		extracted[path] = b
+		return "copy/" + path, err
+	}
+	manifest, err := Index(bytes.NewReader(data), Limits{}, extract)
+	if err != nil {
+		t.Fatalf("Index: %v", err)
+	}
+	if len(manifest.Members) != len(files) {
+		t.Fatalf("%d members, want %d", len(manifest.Members), len(files))
+	}
+	if manifest.Members[0].ArchiveEnd >= int64(len(data))/2 {
+		t.Errorf("first member ends at %d of %d archive bytes", manifest.Members[0].ArchiveEnd, len(data))
+	}
+
+	for i, f := range files {
+		m := &manifest.Members[i]
+		if m.Path != cleanPath(f.name) || m.Size != int64(len(f.content)) || m.ArchiveEnd > int64(len(data)) {
+			t.Errorf("member %d = %+v, want %s with %d bytes", i, m, f.name, len(f.content))
+			continue
+		}
+
+		// Only the escalation members are extracted.
+		copied, ok := extracted[m.Path]
+		if m.Kind == KindOther {
+			if ok || m.Key != "" {
+				t.Errorf("member %s of kind %s extracted to %q", m.Path, m.Kind, m.Key)
+			}
+		} else if m.Key != "copy/"+m.Path || !bytes.Equal(copied, f.content[:min(len(f.content), 1000)]) {
+			t.Errorf("member %s extracted to %q with %d bytes", m.Path, m.Key, len(copied))
+		}
+
+		// Only the archive up to ArchiveEnd is needed.
+		r, err := OpenMember(bytes.NewReader(data[:m.ArchiveEnd]), m)
+		if err != nil {
+			t.Errorf("OpenMember(%s): %v", m.Path, err)
+			continue
+		}
+		content, err := io.ReadAll(r)
+		if err != nil || !bytes.Equal(content, f.content) {
+			t.Errorf("member %s: read %d bytes, err %v, want %d bytes", m.Path, len(content), err, len(f.content))
+		}
+	}
+
+	// An archive cut inside a member reports it rather than a short read.
+	core := &manifest.Members[1]
+	r, err := OpenMember(bytes.NewReader(data[:core.ArchiveEnd/2]), core)
+	if err == nil {
+		_, err = io.ReadAll(r)
+	}
+	if err == nil {
+		t.Error("reading a member from a cut archive succeeded")
+	}
+}
+
+func TestIndexLimits(t *testing.T) {
+	files := []file{
+		{"dump/log/syslog", bytes.Repeat([]byte("a"), 1000)},
+		{"dump/log/syslog.1", bytes.Repeat([]byte("b"), 5000)},
+		{"dump/etc/sonic/config_db.json", bytes.Repeat([]byte("c"), 1000)},
+	}
+	data := archive(t, files)
+
+	tests := []struct {
+		name    string
+		limits  Limits
+		members int
+	}{
+		{"within limits", Limits{MaxBytes: 1 << 20, MaxMemberBytes: 5000}, 3},
+		{"member over limit", Limits{MaxMemberBytes: 4999}, 1},
+		{"archive over limit", Limits{MaxBytes: 5000}, 1},
+		{"archive over limit in a header", Limits{MaxBytes: 8000}, 2},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			manifest, err := Index(bytes.NewReader(data), tc.limits, nil)
+			if tc.members == len(files) {
+				if err != nil {
+					t.Fatalf("Index: %v", err)
+				}
+			} else if !errors.Is(err, ErrTooLarge) || manifest.Error == "" {
+				t.Fatalf("Index error %v, manifest error %q, want ErrTooLarge", err, manifest.Error)
+			}
+			if len(manifest.Members) != tc.members {
+				t.Errorf("%d members indexed, want %d", len(manifest.Members), tc.members)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+// Package telemetry defines the Prometheus metrics Brahma exposes about
+// itself on /metrics. The components that own each measurement update them
+// directly.
+package telemetry
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "brahma"
+
+var (
+	RPCRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "gRPC requests handled, by method and status code.",
+	}, []string{"method", "code"})
+
+	RPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Time to handle a gRPC request, by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	CollectorQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "collector",
+		Name:      "queue_depth",
+		Help:      "Metrics waiting for a collector worker.",
+	})
+
+	CollectorBuffered = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "collector",
+		Name:      "buffered_metrics",
+		Help:      "Metrics held by collector workers until the next flush.",
+	})
+
+	CollectorFlushDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "collector",
+		Name:      "flush_duration_seconds",
+		Help:      "Time to flush a batch of metrics to the event sinks, including spooling failures.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	SplunkEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "splunk",
+		Name:      "events_total",
+		Help:      "Events sent to Splunk HEC, by result (sent or failed).",
+	}, []string{"result"})
+
+	SplunkRequestDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "splunk",
+		Name:      "request_duration_seconds",
+		Help:      "Time of a Splunk HEC request.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	S3UploadBytes = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "s3",
+		Name:      "upload_bytes_total",
+		Help:      "Bytes uploaded to S3.",
+	})
+
+	S3RequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "s3",
+		Name:      "request_duration_seconds",
+		Help:      "Time of an S3 upload request, by operation and result (ok or error).",
+		Buckets:   []float64{.01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60},
+	}, []string{"operation", "result"})
+
+	Devices = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "devices",
+		Help:      "Registered devices, by liveness state.",
+	}, []string{"state"})
+
+	ActiveUploads = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "active_uploads",
+		Help:      "Crash report, backtrace and techsupport uploads in progress, by log type.",
+	}, []string{"log_type"})
+)
+
+// Result is the result label for an operation that returned err.
+func Result(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+// Since returns the seconds elapsed since start, for observing durations.
+func Since(start time.Time) float64 {
+	return time.Since(start).Seconds()
+}
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
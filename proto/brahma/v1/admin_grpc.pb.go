@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: brahma/v1/admin.proto
+
+package brahmav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AdminService_RotateDeviceToken_FullMethodName = "/brahma.v1.AdminService/RotateDeviceToken"
+	AdminService_RevokeDeviceToken_FullMethodName = "/brahma.v1.AdminService/RevokeDeviceToken"
+	AdminService_ListCrashBuckets_FullMethodName  = "/brahma.v1.AdminService/ListCrashBuckets"
+	AdminService_GetCrashBucket_FullMethodName    = "/brahma.v1.AdminService/GetCrashBucket"
+	AdminService_UploadSymbols_FullMethodName     = "/brahma.v1.AdminService/UploadSymbols"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	RotateDeviceToken(ctx context.Context, in *RotateDeviceTokenRequest, opts ...grpc.CallOption) (*RotateDeviceTokenResponse, error)
+	RevokeDeviceToken(ctx context.Context, in *RevokeDeviceTokenRequest, opts ...grpc.CallOption) (*RevokeDeviceTokenResponse, error)
+	ListCrashBuckets(ctx context.Context, in *ListCrashBucketsRequest, opts ...grpc.CallOption) (*ListCrashBucketsResponse, error)
+	GetCrashBucket(ctx context.Context, in *GetCrashBucketRequest, opts ...grpc.CallOption) (*CrashBucket, error)
+	UploadSymbols(ctx context.Context, opts ...grpc.CallOption) (AdminService_UploadSymbolsClient, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) RotateDeviceToken(ctx context.Context, in *RotateDeviceTokenRequest, opts ...grpc.CallOption) (*RotateDeviceTokenResponse, error) {
+	out := new(RotateDeviceTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_RotateDeviceToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeDeviceToken(ctx context.Context, in *RevokeDeviceTokenRequest, opts ...grpc.CallOption) (*RevokeDeviceTokenResponse, error) {
+	out := new(RevokeDeviceTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeDeviceToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListCrashBuckets(ctx context.Context, in *ListCrashBucketsRequest, opts ...grpc.CallOption) (*ListCrashBucketsResponse, error) {
+	out := new(ListCrashBucketsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListCrashBuckets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetCrashBucket(ctx context.Context, in *GetCrashBucketRequest, opts ...grpc.CallOption) (*CrashBucket, error) {
+	out := new(CrashBucket)
+	err := c.cc.Invoke(ctx, AdminService_GetCrashBucket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UploadSymbols(ctx context.Context, opts ...grpc.CallOption) (AdminService_UploadSymbolsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_UploadSymbols_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceUploadSymbolsClient{stream}
+	return x, nil
+}
+
+type AdminService_UploadSymbolsClient interface {
+	Send(*SymbolBundleChunk) error
+	CloseAndRecv() (*UploadSymbolsResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceUploadSymbolsClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceUploadSymbolsClient) Send(m *SymbolBundleChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *adminServiceUploadSymbolsClient) CloseAndRecv() (*UploadSymbolsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(UploadSymbolsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	RotateDeviceToken(context.Context, *RotateDeviceTokenRequest) (*RotateDeviceTokenResponse, error)
+	RevokeDeviceToken(context.Context, *RevokeDeviceTokenRequest) (*RevokeDeviceTokenResponse, error)
+	ListCrashBuckets(context.Context, *ListCrashBucketsRequest) (*ListCrashBucketsResponse, error)
+	GetCrashBucket(context.Context, *GetCrashBucketRequest) (*CrashBucket, error)
+	UploadSymbols(AdminService_UploadSymbolsServer) error
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) RotateDeviceToken(context.Context, *RotateDeviceTokenRequest) (*RotateDeviceTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateDeviceToken not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeDeviceToken(context.Context, *RevokeDeviceTokenRequest) (*RevokeDeviceTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDeviceToken not implemented")
+}
+func (UnimplementedAdminServiceServer) ListCrashBuckets(context.Context, *ListCrashBucketsRequest) (*ListCrashBucketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCrashBuckets not implemented")
+}
+func (UnimplementedAdminServiceServer) GetCrashBucket(context.Context, *GetCrashBucketRequest) (*CrashBucket, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCrashBucket not implemented")
+}
+func (UnimplementedAdminServiceServer) UploadSymbols(AdminService_UploadSymbolsServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadSymbols not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_RotateDeviceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateDeviceTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RotateDeviceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RotateDeviceToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RotateDeviceToken(ctx, req.(*RotateDeviceTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeDeviceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDeviceTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeDeviceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeDeviceToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeDeviceToken(ctx, req.(*RevokeDeviceTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListCrashBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCrashBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListCrashBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListCrashBuckets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListCrashBuckets(ctx, req.(*ListCrashBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetCrashBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCrashBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetCrashBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetCrashBucket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetCrashBucket(ctx, req.(*GetCrashBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UploadSymbols_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AdminServiceServer).UploadSymbols(&adminServiceUploadSymbolsServer{stream})
+}
+
+type AdminService_UploadSymbolsServer interface {
+	SendAndClose(*UploadSymbolsResponse) error
+	Recv() (*SymbolBundleChunk, error)
+	grpc.ServerStream
+}
+
+type adminServiceUploadSymbolsServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceUploadSymbolsServer) SendAndClose(m *UploadSymbolsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *adminServiceUploadSymbolsServer) Recv() (*SymbolBundleChunk, error) {
+	m := new(SymbolBundleChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "brahma.v1.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RotateDeviceToken",
+			Handler:    _AdminService_RotateDeviceToken_Handler,
+		},
+		{
+			MethodName: "RevokeDeviceToken",
+			Handler:    _AdminService_RevokeDeviceToken_Handler,
+		},
+		{
+			MethodName: "ListCrashBuckets",
+			Handler:    _AdminService_ListCrashBuckets_Handler,
+		},
+		{
+			MethodName: "GetCrashBucket",
+			Handler:    _AdminService_GetCrashBucket_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadSymbols",
+			Handler:       _AdminService_UploadSymbols_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "brahma/v1/admin.proto",
+}
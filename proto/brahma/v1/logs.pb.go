@@ -0,0 +1,1803 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: brahma/v1/logs.proto
+
+package brahmav1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CrashReportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CrashReportChunk_Metadata
+	//	*CrashReportChunk_Chunk
+	Data isCrashReportChunk_Data `protobuf_oneof:"data"`
+}
+
+func (x *CrashReportChunk) Reset() {
+	*x = CrashReportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashReportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashReportChunk) ProtoMessage() {}
+
+func (x *CrashReportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashReportChunk.ProtoReflect.Descriptor instead.
+func (*CrashReportChunk) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *CrashReportChunk) GetData() isCrashReportChunk_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CrashReportChunk) GetMetadata() *CrashReportMetadata {
+	if x, ok := x.GetData().(*CrashReportChunk_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CrashReportChunk) GetChunk() []byte {
+	if x, ok := x.GetData().(*CrashReportChunk_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isCrashReportChunk_Data interface {
+	isCrashReportChunk_Data()
+}
+
+type CrashReportChunk_Metadata struct {
+	Metadata *CrashReportMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type CrashReportChunk_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*CrashReportChunk_Metadata) isCrashReportChunk_Data() {}
+
+func (*CrashReportChunk_Chunk) isCrashReportChunk_Data() {}
+
+type CrashReportMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid        string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ProcessTag string `protobuf:"bytes,2,opt,name=process_tag,json=processTag,proto3" json:"process_tag,omitempty"`
+	Version    string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Filename   string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Optional: the content length in bytes and its hex SHA-256. When set the
+	// upload is rejected with DATA_LOSS if the content does not match.
+	Size   int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256 string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *CrashReportMetadata) Reset() {
+	*x = CrashReportMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashReportMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashReportMetadata) ProtoMessage() {}
+
+func (x *CrashReportMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashReportMetadata.ProtoReflect.Descriptor instead.
+func (*CrashReportMetadata) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CrashReportMetadata) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *CrashReportMetadata) GetProcessTag() string {
+	if x != nil {
+		return x.ProcessTag
+	}
+	return ""
+}
+
+func (x *CrashReportMetadata) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CrashReportMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *CrashReportMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *CrashReportMetadata) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type BacktraceChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*BacktraceChunk_Metadata
+	//	*BacktraceChunk_Chunk
+	Data isBacktraceChunk_Data `protobuf_oneof:"data"`
+}
+
+func (x *BacktraceChunk) Reset() {
+	*x = BacktraceChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BacktraceChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacktraceChunk) ProtoMessage() {}
+
+func (x *BacktraceChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacktraceChunk.ProtoReflect.Descriptor instead.
+func (*BacktraceChunk) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *BacktraceChunk) GetData() isBacktraceChunk_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *BacktraceChunk) GetMetadata() *BacktraceMetadata {
+	if x, ok := x.GetData().(*BacktraceChunk_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BacktraceChunk) GetChunk() []byte {
+	if x, ok := x.GetData().(*BacktraceChunk_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isBacktraceChunk_Data interface {
+	isBacktraceChunk_Data()
+}
+
+type BacktraceChunk_Metadata struct {
+	Metadata *BacktraceMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type BacktraceChunk_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*BacktraceChunk_Metadata) isBacktraceChunk_Data() {}
+
+func (*BacktraceChunk_Chunk) isBacktraceChunk_Data() {}
+
+type BacktraceMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid        string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ProcessTag string `protobuf:"bytes,2,opt,name=process_tag,json=processTag,proto3" json:"process_tag,omitempty"`
+	Version    string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Filename   string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Optional: the content length in bytes and its hex SHA-256. When set the
+	// upload is rejected with DATA_LOSS if the content does not match.
+	Size   int64  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256 string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *BacktraceMetadata) Reset() {
+	*x = BacktraceMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BacktraceMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BacktraceMetadata) ProtoMessage() {}
+
+func (x *BacktraceMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BacktraceMetadata.ProtoReflect.Descriptor instead.
+func (*BacktraceMetadata) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BacktraceMetadata) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *BacktraceMetadata) GetProcessTag() string {
+	if x != nil {
+		return x.ProcessTag
+	}
+	return ""
+}
+
+func (x *BacktraceMetadata) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BacktraceMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *BacktraceMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *BacktraceMetadata) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type TechSupportChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*TechSupportChunk_Metadata
+	//	*TechSupportChunk_Chunk
+	Data isTechSupportChunk_Data `protobuf_oneof:"data"`
+}
+
+func (x *TechSupportChunk) Reset() {
+	*x = TechSupportChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TechSupportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechSupportChunk) ProtoMessage() {}
+
+func (x *TechSupportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechSupportChunk.ProtoReflect.Descriptor instead.
+func (*TechSupportChunk) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *TechSupportChunk) GetData() isTechSupportChunk_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *TechSupportChunk) GetMetadata() *TechSupportMetadata {
+	if x, ok := x.GetData().(*TechSupportChunk_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *TechSupportChunk) GetChunk() []byte {
+	if x, ok := x.GetData().(*TechSupportChunk_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isTechSupportChunk_Data interface {
+	isTechSupportChunk_Data()
+}
+
+type TechSupportChunk_Metadata struct {
+	Metadata *TechSupportMetadata `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type TechSupportChunk_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*TechSupportChunk_Metadata) isTechSupportChunk_Data() {}
+
+func (*TechSupportChunk_Chunk) isTechSupportChunk_Data() {}
+
+type TechSupportMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid      string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Version  string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// Optional: the content length in bytes and its hex SHA-256. When set the
+	// upload is rejected with DATA_LOSS if the content does not match.
+	Size   int64  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256 string `protobuf:"bytes,5,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *TechSupportMetadata) Reset() {
+	*x = TechSupportMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TechSupportMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechSupportMetadata) ProtoMessage() {}
+
+func (x *TechSupportMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechSupportMetadata.ProtoReflect.Descriptor instead.
+func (*TechSupportMetadata) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TechSupportMetadata) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *TechSupportMetadata) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *TechSupportMetadata) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *TechSupportMetadata) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TechSupportMetadata) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type LogUploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	LogId   string `protobuf:"bytes,2,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	Uid     string `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	S3Key   string `protobuf:"bytes,4,opt,name=s3_key,json=s3Key,proto3" json:"s3_key,omitempty"`
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *LogUploadResponse) Reset() {
+	*x = LogUploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogUploadResponse) ProtoMessage() {}
+
+func (x *LogUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogUploadResponse.ProtoReflect.Descriptor instead.
+func (*LogUploadResponse) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LogUploadResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *LogUploadResponse) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+func (x *LogUploadResponse) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *LogUploadResponse) GetS3Key() string {
+	if x != nil {
+		return x.S3Key
+	}
+	return ""
+}
+
+func (x *LogUploadResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetLogMetadataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogId string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+}
+
+func (x *GetLogMetadataRequest) Reset() {
+	*x = GetLogMetadataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogMetadataRequest) ProtoMessage() {}
+
+func (x *GetLogMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogMetadataRequest.ProtoReflect.Descriptor instead.
+func (*GetLogMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetLogMetadataRequest) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+type LogMetadataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogId      string                 `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	DeviceUid  string                 `protobuf:"bytes,2,opt,name=device_uid,json=deviceUid,proto3" json:"device_uid,omitempty"`
+	LogType    string                 `protobuf:"bytes,3,opt,name=log_type,json=logType,proto3" json:"log_type,omitempty"`
+	ProcessTag string                 `protobuf:"bytes,4,opt,name=process_tag,json=processTag,proto3" json:"process_tag,omitempty"`
+	Version    string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	Filename   string                 `protobuf:"bytes,6,opt,name=filename,proto3" json:"filename,omitempty"`
+	S3Key      string                 `protobuf:"bytes,7,opt,name=s3_key,json=s3Key,proto3" json:"s3_key,omitempty"`
+	Timestamp  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Crash bucket of a crash report; empty when no backtrace was found.
+	Signature string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
+	// S3 key of the symbolized copy of a backtrace; empty when none was stored.
+	SymbolizedS3Key string `protobuf:"bytes,10,opt,name=symbolized_s3_key,json=symbolizedS3Key,proto3" json:"symbolized_s3_key,omitempty"`
+	// Fields extracted by the crash parser that recognized the log, all empty
+	// when none did. format is one of "gdb", "glibc", "kernel", "python" or
+	// "go"; frames are innermost first, "??" where unsymbolized.
+	Format    string   `protobuf:"bytes,11,opt,name=format,proto3" json:"format,omitempty"`
+	Signal    string   `protobuf:"bytes,12,opt,name=signal,proto3" json:"signal,omitempty"`
+	Thread    string   `protobuf:"bytes,13,opt,name=thread,proto3" json:"thread,omitempty"`
+	Exception string   `protobuf:"bytes,14,opt,name=exception,proto3" json:"exception,omitempty"`
+	Taint     string   `protobuf:"bytes,15,opt,name=taint,proto3" json:"taint,omitempty"`
+	TopFrame  string   `protobuf:"bytes,16,opt,name=top_frame,json=topFrame,proto3" json:"top_frame,omitempty"`
+	Frames    []string `protobuf:"bytes,17,rep,name=frames,proto3" json:"frames,omitempty"`
+	// Number of files indexed from a techsupport archive.
+	MemberCount int32 `protobuf:"varint,18,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	// Size and hex SHA-256 of the stored content.
+	Size   int64  `protobuf:"varint,19,opt,name=size,proto3" json:"size,omitempty"`
+	Sha256 string `protobuf:"bytes,20,opt,name=sha256,proto3" json:"sha256,omitempty"`
+}
+
+func (x *LogMetadataResponse) Reset() {
+	*x = LogMetadataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMetadataResponse) ProtoMessage() {}
+
+func (x *LogMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMetadataResponse.ProtoReflect.Descriptor instead.
+func (*LogMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LogMetadataResponse) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetDeviceUid() string {
+	if x != nil {
+		return x.DeviceUid
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetLogType() string {
+	if x != nil {
+		return x.LogType
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetProcessTag() string {
+	if x != nil {
+		return x.ProcessTag
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetS3Key() string {
+	if x != nil {
+		return x.S3Key
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *LogMetadataResponse) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetSymbolizedS3Key() string {
+	if x != nil {
+		return x.SymbolizedS3Key
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetSignal() string {
+	if x != nil {
+		return x.Signal
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetThread() string {
+	if x != nil {
+		return x.Thread
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetException() string {
+	if x != nil {
+		return x.Exception
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetTaint() string {
+	if x != nil {
+		return x.Taint
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetTopFrame() string {
+	if x != nil {
+		return x.TopFrame
+	}
+	return ""
+}
+
+func (x *LogMetadataResponse) GetFrames() []string {
+	if x != nil {
+		return x.Frames
+	}
+	return nil
+}
+
+func (x *LogMetadataResponse) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *LogMetadataResponse) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *LogMetadataResponse) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+type ListLogsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uid     string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	LogType string `protobuf:"bytes,2,opt,name=log_type,json=logType,proto3" json:"log_type,omitempty"`
+	Limit   int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset  int32  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+}
+
+func (x *ListLogsRequest) Reset() {
+	*x = ListLogsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogsRequest) ProtoMessage() {}
+
+func (x *ListLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListLogsRequest) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListLogsRequest) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ListLogsRequest) GetLogType() string {
+	if x != nil {
+		return x.LogType
+	}
+	return ""
+}
+
+func (x *ListLogsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListLogsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type ListLogsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Logs  []*LogMetadataResponse `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *ListLogsResponse) Reset() {
+	*x = ListLogsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogsResponse) ProtoMessage() {}
+
+func (x *ListLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListLogsResponse) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListLogsResponse) GetLogs() []*LogMetadataResponse {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *ListLogsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DownloadLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogId string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	// Download the symbolized copy of a backtrace instead of the raw one.
+	Symbolized bool `protobuf:"varint,2,opt,name=symbolized,proto3" json:"symbolized,omitempty"`
+	// Download one member file of a techsupport archive, by its path in the
+	// archive, instead of the whole archive.
+	Member string `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *DownloadLogRequest) Reset() {
+	*x = DownloadLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DownloadLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadLogRequest) ProtoMessage() {}
+
+func (x *DownloadLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadLogRequest.ProtoReflect.Descriptor instead.
+func (*DownloadLogRequest) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DownloadLogRequest) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+func (x *DownloadLogRequest) GetSymbolized() bool {
+	if x != nil {
+		return x.Symbolized
+	}
+	return false
+}
+
+func (x *DownloadLogRequest) GetMember() string {
+	if x != nil {
+		return x.Member
+	}
+	return ""
+}
+
+type LogDownloadChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*LogDownloadChunk_Metadata
+	//	*LogDownloadChunk_Chunk
+	Data isLogDownloadChunk_Data `protobuf_oneof:"data"`
+}
+
+func (x *LogDownloadChunk) Reset() {
+	*x = LogDownloadChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogDownloadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogDownloadChunk) ProtoMessage() {}
+
+func (x *LogDownloadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogDownloadChunk.ProtoReflect.Descriptor instead.
+func (*LogDownloadChunk) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *LogDownloadChunk) GetData() isLogDownloadChunk_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *LogDownloadChunk) GetMetadata() *LogMetadataResponse {
+	if x, ok := x.GetData().(*LogDownloadChunk_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *LogDownloadChunk) GetChunk() []byte {
+	if x, ok := x.GetData().(*LogDownloadChunk_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isLogDownloadChunk_Data interface {
+	isLogDownloadChunk_Data()
+}
+
+type LogDownloadChunk_Metadata struct {
+	Metadata *LogMetadataResponse `protobuf:"bytes,1,opt,name=metadata,proto3,oneof"`
+}
+
+type LogDownloadChunk_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*LogDownloadChunk_Metadata) isLogDownloadChunk_Data() {}
+
+func (*LogDownloadChunk_Chunk) isLogDownloadChunk_Data() {}
+
+type GetTechSupportManifestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogId string `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+}
+
+func (x *GetTechSupportManifestRequest) Reset() {
+	*x = GetTechSupportManifestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTechSupportManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTechSupportManifestRequest) ProtoMessage() {}
+
+func (x *GetTechSupportManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTechSupportManifestRequest.ProtoReflect.Descriptor instead.
+func (*GetTechSupportManifestRequest) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTechSupportManifestRequest) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+type TechSupportMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// One of "syslog", "core", "config_db", "redis_dump" or "other".
+	Kind    string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Size    int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	ModTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=mod_time,json=modTime,proto3" json:"mod_time,omitempty"`
+}
+
+func (x *TechSupportMember) Reset() {
+	*x = TechSupportMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TechSupportMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechSupportMember) ProtoMessage() {}
+
+func (x *TechSupportMember) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechSupportMember.ProtoReflect.Descriptor instead.
+func (*TechSupportMember) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TechSupportMember) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TechSupportMember) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *TechSupportMember) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TechSupportMember) GetModTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModTime
+	}
+	return nil
+}
+
+type TechSupportManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LogId   string               `protobuf:"bytes,1,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	Members []*TechSupportMember `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	// Set when the archive could not be read to the end or is over the
+	// indexing limits; the members before the problem are still listed.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TechSupportManifest) Reset() {
+	*x = TechSupportManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_brahma_v1_logs_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TechSupportManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TechSupportManifest) ProtoMessage() {}
+
+func (x *TechSupportManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_brahma_v1_logs_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TechSupportManifest.ProtoReflect.Descriptor instead.
+func (*TechSupportManifest) Descriptor() ([]byte, []int) {
+	return file_brahma_v1_logs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *TechSupportManifest) GetLogId() string {
+	if x != nil {
+		return x.LogId
+	}
+	return ""
+}
+
+func (x *TechSupportManifest) GetMembers() []*TechSupportMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *TechSupportManifest) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_brahma_v1_logs_proto protoreflect.FileDescriptor
+
+var file_brahma_v1_logs_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x67, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76,
+	0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x70, 0x0a, 0x10, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xaa, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x67, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x22, 0x6c, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0xa8, 0x01, 0x0a, 0x11, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x70, 0x0a, 0x10, 0x54, 0x65,
+	0x63, 0x68, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x3c,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x63,
+	0x68, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x89, 0x01, 0x0a,
+	0x13, 0x54, 0x65, 0x63, 0x68, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x4c, 0x6f, 0x67,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x73, 0x33, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x33, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x2e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x6f, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64,
+	0x22, 0xd8, 0x04, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12,
+	0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x54, 0x61, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x15, 0x0a, 0x06, 0x73, 0x33, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x33, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x73, 0x33,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x79, 0x6d, 0x62,
+	0x6f, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x33, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x70, 0x5f, 0x66,
+	0x72, 0x61, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x70, 0x46,
+	0x72, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x11,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35, 0x36, 0x22, 0x6c, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x69, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x5c, 0x0a, 0x10, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x04, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x72,
+	0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x63, 0x0a, 0x12, 0x44, 0x6f, 0x77, 0x6e, 0x6c,
+	0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a,
+	0x06, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x6f, 0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x70, 0x0a, 0x10,
+	0x4c, 0x6f, 0x67, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x12, 0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x36,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x15, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x54, 0x65, 0x63, 0x68, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6d, 0x6f, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x7a, 0x0a, 0x13, 0x54, 0x65, 0x63, 0x68, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x12, 0x36, 0x0a,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0xc8, 0x04, 0x0a, 0x0a,
+	0x4c, 0x6f, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1b, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x1c, 0x2e, 0x62,
+	0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x4c, 0x0a, 0x0f,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x12,
+	0x19, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b,
+	0x74, 0x72, 0x61, 0x63, 0x65, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x1a, 0x1c, 0x2e, 0x62, 0x72, 0x61,
+	0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x52, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x20, 0x2e, 0x62,
+	0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
+	0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1a, 0x2e, 0x62, 0x72, 0x61,
+	0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4c,
+	0x6f, 0x67, 0x12, 0x1d, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x30, 0x01,
+	0x12, 0x50, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x54, 0x65, 0x63, 0x68, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75,
+	0x6e, 0x6b, 0x1a, 0x1c, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x67, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x01, 0x12, 0x62, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68, 0x53, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x28, 0x2e, 0x62,
+	0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x65, 0x63, 0x68,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x65, 0x63, 0x68, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x76, 0x74, 0x61, 0x70, 0x61, 0x73, 0x6b, 0x61, 0x72, 0x2f, 0x62,
+	0x72, 0x61, 0x68, 0x6d, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x72, 0x61, 0x68,
+	0x6d, 0x61, 0x2f, 0x76, 0x31, 0x3b, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_brahma_v1_logs_proto_rawDescOnce sync.Once
+	file_brahma_v1_logs_proto_rawDescData = file_brahma_v1_logs_proto_rawDesc
+)
+
+func file_brahma_v1_logs_proto_rawDescGZIP() []byte {
+	file_brahma_v1_logs_proto_rawDescOnce.Do(func() {
+		file_brahma_v1_logs_proto_rawDescData = protoimpl.X.CompressGZIP(file_brahma_v1_logs_proto_rawDescData)
+	})
+	return file_brahma_v1_logs_proto_rawDescData
+}
+
+var file_brahma_v1_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_brahma_v1_logs_proto_goTypes = []interface{}{
+	(*CrashReportChunk)(nil),              // 0: brahma.v1.CrashReportChunk
+	(*CrashReportMetadata)(nil),           // 1: brahma.v1.CrashReportMetadata
+	(*BacktraceChunk)(nil),                // 2: brahma.v1.BacktraceChunk
+	(*BacktraceMetadata)(nil),             // 3: brahma.v1.BacktraceMetadata
+	(*TechSupportChunk)(nil),              // 4: brahma.v1.TechSupportChunk
+	(*TechSupportMetadata)(nil),           // 5: brahma.v1.TechSupportMetadata
+	(*LogUploadResponse)(nil),             // 6: brahma.v1.LogUploadResponse
+	(*GetLogMetadataRequest)(nil),         // 7: brahma.v1.GetLogMetadataRequest
+	(*LogMetadataResponse)(nil),           // 8: brahma.v1.LogMetadataResponse
+	(*ListLogsRequest)(nil),               // 9: brahma.v1.ListLogsRequest
+	(*ListLogsResponse)(nil),              // 10: brahma.v1.ListLogsResponse
+	(*DownloadLogRequest)(nil),            // 11: brahma.v1.DownloadLogRequest
+	(*LogDownloadChunk)(nil),              // 12: brahma.v1.LogDownloadChunk
+	(*GetTechSupportManifestRequest)(nil), // 13: brahma.v1.GetTechSupportManifestRequest
+	(*TechSupportMember)(nil),             // 14: brahma.v1.TechSupportMember
+	(*TechSupportManifest)(nil),           // 15: brahma.v1.TechSupportManifest
+	(*timestamppb.Timestamp)(nil),         // 16: google.protobuf.Timestamp
+}
+var file_brahma_v1_logs_proto_depIdxs = []int32{
+	1,  // 0: brahma.v1.CrashReportChunk.metadata:type_name -> brahma.v1.CrashReportMetadata
+	3,  // 1: brahma.v1.BacktraceChunk.metadata:type_name -> brahma.v1.BacktraceMetadata
+	5,  // 2: brahma.v1.TechSupportChunk.metadata:type_name -> brahma.v1.TechSupportMetadata
+	16, // 3: brahma.v1.LogMetadataResponse.timestamp:type_name -> google.protobuf.Timestamp
+	8,  // 4: brahma.v1.ListLogsResponse.logs:type_name -> brahma.v1.LogMetadataResponse
+	8,  // 5: brahma.v1.LogDownloadChunk.metadata:type_name -> brahma.v1.LogMetadataResponse
+	16, // 6: brahma.v1.TechSupportMember.mod_time:type_name -> google.protobuf.Timestamp
+	14, // 7: brahma.v1.TechSupportManifest.members:type_name -> brahma.v1.TechSupportMember
+	0,  // 8: brahma.v1.LogService.UploadCrashReport:input_type -> brahma.v1.CrashReportChunk
+	2,  // 9: brahma.v1.LogService.UploadBacktrace:input_type -> brahma.v1.BacktraceChunk
+	7,  // 10: brahma.v1.LogService.GetLogMetadata:input_type -> brahma.v1.GetLogMetadataRequest
+	9,  // 11: brahma.v1.LogService.ListLogs:input_type -> brahma.v1.ListLogsRequest
+	11, // 12: brahma.v1.LogService.DownloadLog:input_type -> brahma.v1.DownloadLogRequest
+	4,  // 13: brahma.v1.LogService.UploadTechSupport:input_type -> brahma.v1.TechSupportChunk
+	13, // 14: brahma.v1.LogService.GetTechSupportManifest:input_type -> brahma.v1.GetTechSupportManifestRequest
+	6,  // 15: brahma.v1.LogService.UploadCrashReport:output_type -> brahma.v1.LogUploadResponse
+	6,  // 16: brahma.v1.LogService.UploadBacktrace:output_type -> brahma.v1.LogUploadResponse
+	8,  // 17: brahma.v1.LogService.GetLogMetadata:output_type -> brahma.v1.LogMetadataResponse
+	10, // 18: brahma.v1.LogService.ListLogs:output_type -> brahma.v1.ListLogsResponse
+	12, // 19: brahma.v1.LogService.DownloadLog:output_type -> brahma.v1.LogDownloadChunk
+	6,  // 20: brahma.v1.LogService.UploadTechSupport:output_type -> brahma.v1.LogUploadResponse
+	15, // 21: brahma.v1.LogService.GetTechSupportManifest:output_type -> brahma.v1.TechSupportManifest
+	15, // [15:22] is the sub-list for method output_type
+	8,  // [8:15] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_brahma_v1_logs_proto_init() }
+func file_brahma_v1_logs_proto_init() {
+	if File_brahma_v1_logs_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_brahma_v1_logs_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashReportChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashReportMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BacktraceChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BacktraceMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TechSupportChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TechSupportMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogUploadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogMetadataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogMetadataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DownloadLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogDownloadChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTechSupportManifestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TechSupportMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_brahma_v1_logs_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TechSupportManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_brahma_v1_logs_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*CrashReportChunk_Metadata)(nil),
+		(*CrashReportChunk_Chunk)(nil),
+	}
+	file_brahma_v1_logs_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*BacktraceChunk_Metadata)(nil),
+		(*BacktraceChunk_Chunk)(nil),
+	}
+	file_brahma_v1_logs_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*TechSupportChunk_Metadata)(nil),
+		(*TechSupportChunk_Chunk)(nil),
+	}
+	file_brahma_v1_logs_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*LogDownloadChunk_Metadata)(nil),
+		(*LogDownloadChunk_Chunk)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_brahma_v1_logs_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_brahma_v1_logs_proto_goTypes,
+		DependencyIndexes: file_brahma_v1_logs_proto_depIdxs,
+		MessageInfos:      file_brahma_v1_logs_proto_msgTypes,
+	}.Build()
+	File_brahma_v1_logs_proto = out.File
+	file_brahma_v1_logs_proto_rawDesc = nil
+	file_brahma_v1_logs_proto_goTypes = nil
+	file_brahma_v1_logs_proto_depIdxs = nil
+}
@@ -0,0 +1,467 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: brahma/v1/logs.proto
+
+package brahmav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LogService_UploadCrashReport_FullMethodName      = "/brahma.v1.LogService/UploadCrashReport"
+	LogService_UploadBacktrace_FullMethodName        = "/brahma.v1.LogService/UploadBacktrace"
+	LogService_GetLogMetadata_FullMethodName         = "/brahma.v1.LogService/GetLogMetadata"
+	LogService_ListLogs_FullMethodName               = "/brahma.v1.LogService/ListLogs"
+	LogService_DownloadLog_FullMethodName            = "/brahma.v1.LogService/DownloadLog"
+	LogService_UploadTechSupport_FullMethodName      = "/brahma.v1.LogService/UploadTechSupport"
+	LogService_GetTechSupportManifest_FullMethodName = "/brahma.v1.LogService/GetTechSupportManifest"
+)
+
+// LogServiceClient is the client API for LogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LogServiceClient interface {
+	UploadCrashReport(ctx context.Context, opts ...grpc.CallOption) (LogService_UploadCrashReportClient, error)
+	UploadBacktrace(ctx context.Context, opts ...grpc.CallOption) (LogService_UploadBacktraceClient, error)
+	GetLogMetadata(ctx context.Context, in *GetLogMetadataRequest, opts ...grpc.CallOption) (*LogMetadataResponse, error)
+	ListLogs(ctx context.Context, in *ListLogsRequest, opts ...grpc.CallOption) (*ListLogsResponse, error)
+	DownloadLog(ctx context.Context, in *DownloadLogRequest, opts ...grpc.CallOption) (LogService_DownloadLogClient, error)
+	// Upload a sonic_dump_*.tar.gz archive from "show techsupport". Its member
+	// files are indexed once it is stored, so they can be downloaded one at a
+	// time.
+	UploadTechSupport(ctx context.Context, opts ...grpc.CallOption) (LogService_UploadTechSupportClient, error)
+	GetTechSupportManifest(ctx context.Context, in *GetTechSupportManifestRequest, opts ...grpc.CallOption) (*TechSupportManifest, error)
+}
+
+type logServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLogServiceClient(cc grpc.ClientConnInterface) LogServiceClient {
+	return &logServiceClient{cc}
+}
+
+func (c *logServiceClient) UploadCrashReport(ctx context.Context, opts ...grpc.CallOption) (LogService_UploadCrashReportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogService_ServiceDesc.Streams[0], LogService_UploadCrashReport_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logServiceUploadCrashReportClient{stream}
+	return x, nil
+}
+
+type LogService_UploadCrashReportClient interface {
+	Send(*CrashReportChunk) error
+	CloseAndRecv() (*LogUploadResponse, error)
+	grpc.ClientStream
+}
+
+type logServiceUploadCrashReportClient struct {
+	grpc.ClientStream
+}
+
+func (x *logServiceUploadCrashReportClient) Send(m *CrashReportChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *logServiceUploadCrashReportClient) CloseAndRecv() (*LogUploadResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(LogUploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logServiceClient) UploadBacktrace(ctx context.Context, opts ...grpc.CallOption) (LogService_UploadBacktraceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogService_ServiceDesc.Streams[1], LogService_UploadBacktrace_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logServiceUploadBacktraceClient{stream}
+	return x, nil
+}
+
+type LogService_UploadBacktraceClient interface {
+	Send(*BacktraceChunk) error
+	CloseAndRecv() (*LogUploadResponse, error)
+	grpc.ClientStream
+}
+
+type logServiceUploadBacktraceClient struct {
+	grpc.ClientStream
+}
+
+func (x *logServiceUploadBacktraceClient) Send(m *BacktraceChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *logServiceUploadBacktraceClient) CloseAndRecv() (*LogUploadResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(LogUploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logServiceClient) GetLogMetadata(ctx context.Context, in *GetLogMetadataRequest, opts ...grpc.CallOption) (*LogMetadataResponse, error) {
+	out := new(LogMetadataResponse)
+	err := c.cc.Invoke(ctx, LogService_GetLogMetadata_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) ListLogs(ctx context.Context, in *ListLogsRequest, opts ...grpc.CallOption) (*ListLogsResponse, error) {
+	out := new(ListLogsResponse)
+	err := c.cc.Invoke(ctx, LogService_ListLogs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logServiceClient) DownloadLog(ctx context.Context, in *DownloadLogRequest, opts ...grpc.CallOption) (LogService_DownloadLogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogService_ServiceDesc.Streams[2], LogService_DownloadLog_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logServiceDownloadLogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LogService_DownloadLogClient interface {
+	Recv() (*LogDownloadChunk, error)
+	grpc.ClientStream
+}
+
+type logServiceDownloadLogClient struct {
+	grpc.ClientStream
+}
+
+func (x *logServiceDownloadLogClient) Recv() (*LogDownloadChunk, error) {
+	m := new(LogDownloadChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logServiceClient) UploadTechSupport(ctx context.Context, opts ...grpc.CallOption) (LogService_UploadTechSupportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &LogService_ServiceDesc.Streams[3], LogService_UploadTechSupport_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logServiceUploadTechSupportClient{stream}
+	return x, nil
+}
+
+type LogService_UploadTechSupportClient interface {
+	Send(*TechSupportChunk) error
+	CloseAndRecv() (*LogUploadResponse, error)
+	grpc.ClientStream
+}
+
+type logServiceUploadTechSupportClient struct {
+	grpc.ClientStream
+}
+
+func (x *logServiceUploadTechSupportClient) Send(m *TechSupportChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *logServiceUploadTechSupportClient) CloseAndRecv() (*LogUploadResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(LogUploadResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logServiceClient) GetTechSupportManifest(ctx context.Context, in *GetTechSupportManifestRequest, opts ...grpc.CallOption) (*TechSupportManifest, error) {
+	out := new(TechSupportManifest)
+	err := c.cc.Invoke(ctx, LogService_GetTechSupportManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LogServiceServer is the server API for LogService service.
+// All implementations must embed UnimplementedLogServiceServer
+// for forward compatibility
+type LogServiceServer interface {
+	UploadCrashReport(LogService_UploadCrashReportServer) error
+	UploadBacktrace(LogService_UploadBacktraceServer) error
+	GetLogMetadata(context.Context, *GetLogMetadataRequest) (*LogMetadataResponse, error)
+	ListLogs(context.Context, *ListLogsRequest) (*ListLogsResponse, error)
+	DownloadLog(*DownloadLogRequest, LogService_DownloadLogServer) error
+	// Upload a sonic_dump_*.tar.gz archive from "show techsupport". Its member
+	// files are indexed once it is stored, so they can be downloaded one at a
+	// time.
+	UploadTechSupport(LogService_UploadTechSupportServer) error
+	GetTechSupportManifest(context.Context, *GetTechSupportManifestRequest) (*TechSupportManifest, error)
+	mustEmbedUnimplementedLogServiceServer()
+}
+
+// UnimplementedLogServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLogServiceServer struct {
+}
+
+func (UnimplementedLogServiceServer) UploadCrashReport(LogService_UploadCrashReportServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadCrashReport not implemented")
+}
+func (UnimplementedLogServiceServer) UploadBacktrace(LogService_UploadBacktraceServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBacktrace not implemented")
+}
+func (UnimplementedLogServiceServer) GetLogMetadata(context.Context, *GetLogMetadataRequest) (*LogMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogMetadata not implemented")
+}
+func (UnimplementedLogServiceServer) ListLogs(context.Context, *ListLogsRequest) (*ListLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLogs not implemented")
+}
+func (UnimplementedLogServiceServer) DownloadLog(*DownloadLogRequest, LogService_DownloadLogServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadLog not implemented")
+}
+func (UnimplementedLogServiceServer) UploadTechSupport(LogService_UploadTechSupportServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadTechSupport not implemented")
+}
+func (UnimplementedLogServiceServer) GetTechSupportManifest(context.Context, *GetTechSupportManifestRequest) (*TechSupportManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTechSupportManifest not implemented")
+}
+func (UnimplementedLogServiceServer) mustEmbedUnimplementedLogServiceServer() {}
+
+// UnsafeLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LogServiceServer will
+// result in compilation errors.
+type UnsafeLogServiceServer interface {
+	mustEmbedUnimplementedLogServiceServer()
+}
+
+func RegisterLogServiceServer(s grpc.ServiceRegistrar, srv LogServiceServer) {
+	s.RegisterService(&LogService_ServiceDesc, srv)
+}
+
+func _LogService_UploadCrashReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LogServiceServer).UploadCrashReport(&logServiceUploadCrashReportServer{stream})
+}
+
+type LogService_UploadCrashReportServer interface {
+	SendAndClose(*LogUploadResponse) error
+	Recv() (*CrashReportChunk, error)
+	grpc.ServerStream
+}
+
+type logServiceUploadCrashReportServer struct {
+	grpc.ServerStream
+}
+
+func (x *logServiceUploadCrashReportServer) SendAndClose(m *LogUploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *logServiceUploadCrashReportServer) Recv() (*CrashReportChunk, error) {
+	m := new(CrashReportChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LogService_UploadBacktrace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LogServiceServer).UploadBacktrace(&logServiceUploadBacktraceServer{stream})
+}
+
+type LogService_UploadBacktraceServer interface {
+	SendAndClose(*LogUploadResponse) error
+	Recv() (*BacktraceChunk, error)
+	grpc.ServerStream
+}
+
+type logServiceUploadBacktraceServer struct {
+	grpc.ServerStream
+}
+
+func (x *logServiceUploadBacktraceServer) SendAndClose(m *LogUploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *logServiceUploadBacktraceServer) Recv() (*BacktraceChunk, error) {
+	m := new(BacktraceChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LogService_GetLogMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).GetLogMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_GetLogMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).GetLogMetadata(ctx, req.(*GetLogMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_ListLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).ListLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_ListLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).ListLogs(ctx, req.(*ListLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogService_DownloadLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogServiceServer).DownloadLog(m, &logServiceDownloadLogServer{stream})
+}
+
+type LogService_DownloadLogServer interface {
+	Send(*LogDownloadChunk) error
+	grpc.ServerStream
+}
+
+type logServiceDownloadLogServer struct {
+	grpc.ServerStream
+}
+
+func (x *logServiceDownloadLogServer) Send(m *LogDownloadChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LogService_UploadTechSupport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LogServiceServer).UploadTechSupport(&logServiceUploadTechSupportServer{stream})
+}
+
+type LogService_UploadTechSupportServer interface {
+	SendAndClose(*LogUploadResponse) error
+	Recv() (*TechSupportChunk, error)
+	grpc.ServerStream
+}
+
+type logServiceUploadTechSupportServer struct {
+	grpc.ServerStream
+}
+
+func (x *logServiceUploadTechSupportServer) SendAndClose(m *LogUploadResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *logServiceUploadTechSupportServer) Recv() (*TechSupportChunk, error) {
+	m := new(TechSupportChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LogService_GetTechSupportManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTechSupportManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogServiceServer).GetTechSupportManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogService_GetTechSupportManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogServiceServer).GetTechSupportManifest(ctx, req.(*GetTechSupportManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LogService_ServiceDesc is the grpc.ServiceDesc for LogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "brahma.v1.LogService",
+	HandlerType: (*LogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLogMetadata",
+			Handler:    _LogService_GetLogMetadata_Handler,
+		},
+		{
+			MethodName: "ListLogs",
+			Handler:    _LogService_ListLogs_Handler,
+		},
+		{
+			MethodName: "GetTechSupportManifest",
+			Handler:    _LogService_GetTechSupportManifest_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadCrashReport",
+			Handler:       _LogService_UploadCrashReport_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "UploadBacktrace",
+			Handler:       _LogService_UploadBacktrace_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadLog",
+			Handler:       _LogService_DownloadLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadTechSupport",
+			Handler:       _LogService_UploadTechSupport_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "brahma/v1/logs.proto",
+}
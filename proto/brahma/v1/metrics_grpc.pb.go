@@ -0,0 +1,440 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: brahma/v1/metrics.proto
+
+package brahmav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MetricsService_ReportCPUStats_FullMethodName         = "/brahma.v1.MetricsService/ReportCPUStats"
+	MetricsService_ReportProcessStats_FullMethodName     = "/brahma.v1.MetricsService/ReportProcessStats"
+	MetricsService_ReportMgmtNetworkStats_FullMethodName = "/brahma.v1.MetricsService/ReportMgmtNetworkStats"
+	MetricsService_ReportRouterBaseState_FullMethodName  = "/brahma.v1.MetricsService/ReportRouterBaseState"
+	MetricsService_ReportInterfaceStats_FullMethodName   = "/brahma.v1.MetricsService/ReportInterfaceStats"
+	MetricsService_ReportBGPNeighbors_FullMethodName     = "/brahma.v1.MetricsService/ReportBGPNeighbors"
+	MetricsService_ReportVXLANTunnels_FullMethodName     = "/brahma.v1.MetricsService/ReportVXLANTunnels"
+	MetricsService_ReportQoSQueues_FullMethodName        = "/brahma.v1.MetricsService/ReportQoSQueues"
+	MetricsService_StreamMetrics_FullMethodName          = "/brahma.v1.MetricsService/StreamMetrics"
+)
+
+// MetricsServiceClient is the client API for MetricsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MetricsServiceClient interface {
+	ReportCPUStats(ctx context.Context, in *CPUStatsRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	ReportProcessStats(ctx context.Context, in *ProcessStatsRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	ReportMgmtNetworkStats(ctx context.Context, in *MgmtNetworkStatsRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	ReportRouterBaseState(ctx context.Context, in *RouterBaseStateRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	ReportInterfaceStats(ctx context.Context, in *InterfaceStatsRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	ReportBGPNeighbors(ctx context.Context, in *BGPNeighborsRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	ReportVXLANTunnels(ctx context.Context, in *VXLANTunnelsRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	ReportQoSQueues(ctx context.Context, in *QoSQueuesRequest, opts ...grpc.CallOption) (*MetricsResponse, error)
+	StreamMetrics(ctx context.Context, opts ...grpc.CallOption) (MetricsService_StreamMetricsClient, error)
+}
+
+type metricsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMetricsServiceClient(cc grpc.ClientConnInterface) MetricsServiceClient {
+	return &metricsServiceClient{cc}
+}
+
+func (c *metricsServiceClient) ReportCPUStats(ctx context.Context, in *CPUStatsRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ReportCPUStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) ReportProcessStats(ctx context.Context, in *ProcessStatsRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ReportProcessStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) ReportMgmtNetworkStats(ctx context.Context, in *MgmtNetworkStatsRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ReportMgmtNetworkStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) ReportRouterBaseState(ctx context.Context, in *RouterBaseStateRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ReportRouterBaseState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) ReportInterfaceStats(ctx context.Context, in *InterfaceStatsRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ReportInterfaceStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) ReportBGPNeighbors(ctx context.Context, in *BGPNeighborsRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ReportBGPNeighbors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) ReportVXLANTunnels(ctx context.Context, in *VXLANTunnelsRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ReportVXLANTunnels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) ReportQoSQueues(ctx context.Context, in *QoSQueuesRequest, opts ...grpc.CallOption) (*MetricsResponse, error) {
+	out := new(MetricsResponse)
+	err := c.cc.Invoke(ctx, MetricsService_ReportQoSQueues_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metricsServiceClient) StreamMetrics(ctx context.Context, opts ...grpc.CallOption) (MetricsService_StreamMetricsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MetricsService_ServiceDesc.Streams[0], MetricsService_StreamMetrics_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &metricsServiceStreamMetricsClient{stream}
+	return x, nil
+}
+
+type MetricsService_StreamMetricsClient interface {
+	Send(*MetricsStreamRequest) error
+	CloseAndRecv() (*MetricsResponse, error)
+	grpc.ClientStream
+}
+
+type metricsServiceStreamMetricsClient struct {
+	grpc.ClientStream
+}
+
+func (x *metricsServiceStreamMetricsClient) Send(m *MetricsStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *metricsServiceStreamMetricsClient) CloseAndRecv() (*MetricsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(MetricsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MetricsServiceServer is the server API for MetricsService service.
+// All implementations must embed UnimplementedMetricsServiceServer
+// for forward compatibility
+type MetricsServiceServer interface {
+	ReportCPUStats(context.Context, *CPUStatsRequest) (*MetricsResponse, error)
+	ReportProcessStats(context.Context, *ProcessStatsRequest) (*MetricsResponse, error)
+	ReportMgmtNetworkStats(context.Context, *MgmtNetworkStatsRequest) (*MetricsResponse, error)
+	ReportRouterBaseState(context.Context, *RouterBaseStateRequest) (*MetricsResponse, error)
+	ReportInterfaceStats(context.Context, *InterfaceStatsRequest) (*MetricsResponse, error)
+	ReportBGPNeighbors(context.Context, *BGPNeighborsRequest) (*MetricsResponse, error)
+	ReportVXLANTunnels(context.Context, *VXLANTunnelsRequest) (*MetricsResponse, error)
+	ReportQoSQueues(context.Context, *QoSQueuesRequest) (*MetricsResponse, error)
+	StreamMetrics(MetricsService_StreamMetricsServer) error
+	mustEmbedUnimplementedMetricsServiceServer()
+}
+
+// UnimplementedMetricsServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMetricsServiceServer struct {
+}
+
+func (UnimplementedMetricsServiceServer) ReportCPUStats(context.Context, *CPUStatsRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCPUStats not implemented")
+}
+func (UnimplementedMetricsServiceServer) ReportProcessStats(context.Context, *ProcessStatsRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportProcessStats not implemented")
+}
+func (UnimplementedMetricsServiceServer) ReportMgmtNetworkStats(context.Context, *MgmtNetworkStatsRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMgmtNetworkStats not implemented")
+}
+func (UnimplementedMetricsServiceServer) ReportRouterBaseState(context.Context, *RouterBaseStateRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportRouterBaseState not implemented")
+}
+func (UnimplementedMetricsServiceServer) ReportInterfaceStats(context.Context, *InterfaceStatsRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportInterfaceStats not implemented")
+}
+func (UnimplementedMetricsServiceServer) ReportBGPNeighbors(context.Context, *BGPNeighborsRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportBGPNeighbors not implemented")
+}
+func (UnimplementedMetricsServiceServer) ReportVXLANTunnels(context.Context, *VXLANTunnelsRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportVXLANTunnels not implemented")
+}
+func (UnimplementedMetricsServiceServer) ReportQoSQueues(context.Context, *QoSQueuesRequest) (*MetricsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportQoSQueues not implemented")
+}
+func (UnimplementedMetricsServiceServer) StreamMetrics(MetricsService_StreamMetricsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamMetrics not implemented")
+}
+func (UnimplementedMetricsServiceServer) mustEmbedUnimplementedMetricsServiceServer() {}
+
+// UnsafeMetricsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetricsServiceServer will
+// result in compilation errors.
+type UnsafeMetricsServiceServer interface {
+	mustEmbedUnimplementedMetricsServiceServer()
+}
+
+func RegisterMetricsServiceServer(s grpc.ServiceRegistrar, srv MetricsServiceServer) {
+	s.RegisterService(&MetricsService_ServiceDesc, srv)
+}
+
+func _MetricsService_ReportCPUStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CPUStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ReportCPUStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ReportCPUStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ReportCPUStats(ctx, req.(*CPUStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_ReportProcessStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ReportProcessStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ReportProcessStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ReportProcessStats(ctx, req.(*ProcessStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_ReportMgmtNetworkStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MgmtNetworkStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ReportMgmtNetworkStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ReportMgmtNetworkStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ReportMgmtNetworkStats(ctx, req.(*MgmtNetworkStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_ReportRouterBaseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouterBaseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ReportRouterBaseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ReportRouterBaseState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ReportRouterBaseState(ctx, req.(*RouterBaseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_ReportInterfaceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterfaceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ReportInterfaceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ReportInterfaceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ReportInterfaceStats(ctx, req.(*InterfaceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_ReportBGPNeighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BGPNeighborsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ReportBGPNeighbors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ReportBGPNeighbors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ReportBGPNeighbors(ctx, req.(*BGPNeighborsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_ReportVXLANTunnels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VXLANTunnelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ReportVXLANTunnels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ReportVXLANTunnels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ReportVXLANTunnels(ctx, req.(*VXLANTunnelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_ReportQoSQueues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QoSQueuesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricsServiceServer).ReportQoSQueues(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetricsService_ReportQoSQueues_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricsServiceServer).ReportQoSQueues(ctx, req.(*QoSQueuesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetricsService_StreamMetrics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MetricsServiceServer).StreamMetrics(&metricsServiceStreamMetricsServer{stream})
+}
+
+type MetricsService_StreamMetricsServer interface {
+	SendAndClose(*MetricsResponse) error
+	Recv() (*MetricsStreamRequest, error)
+	grpc.ServerStream
+}
+
+type metricsServiceStreamMetricsServer struct {
+	grpc.ServerStream
+}
+
+func (x *metricsServiceStreamMetricsServer) SendAndClose(m *MetricsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *metricsServiceStreamMetricsServer) Recv() (*MetricsStreamRequest, error) {
+	m := new(MetricsStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// MetricsService_ServiceDesc is the grpc.ServiceDesc for MetricsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MetricsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "brahma.v1.MetricsService",
+	HandlerType: (*MetricsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportCPUStats",
+			Handler:    _MetricsService_ReportCPUStats_Handler,
+		},
+		{
+			MethodName: "ReportProcessStats",
+			Handler:    _MetricsService_ReportProcessStats_Handler,
+		},
+		{
+			MethodName: "ReportMgmtNetworkStats",
+			Handler:    _MetricsService_ReportMgmtNetworkStats_Handler,
+		},
+		{
+			MethodName: "ReportRouterBaseState",
+			Handler:    _MetricsService_ReportRouterBaseState_Handler,
+		},
+		{
+			MethodName: "ReportInterfaceStats",
+			Handler:    _MetricsService_ReportInterfaceStats_Handler,
+		},
+		{
+			MethodName: "ReportBGPNeighbors",
+			Handler:    _MetricsService_ReportBGPNeighbors_Handler,
+		},
+		{
+			MethodName: "ReportVXLANTunnels",
+			Handler:    _MetricsService_ReportVXLANTunnels_Handler,
+		},
+		{
+			MethodName: "ReportQoSQueues",
+			Handler:    _MetricsService_ReportQoSQueues_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamMetrics",
+			Handler:       _MetricsService_StreamMetrics_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "brahma/v1/metrics.proto",
+}